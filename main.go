@@ -17,36 +17,311 @@
 package main
 
 import (
+	"context"
+	"crypto/tls"
+	"errors"
 	"flag"
 	"fmt"
 	"log"
 	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
 
 	pb "github.com/casbin/casbin-server/proto"
 	"github.com/casbin/casbin-server/server"
+	authv3 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/reflection"
 )
 
+// validateConfig runs "casbin-server config validate", which checks the config files
+// like the server does when it starts, and returns the exit code.
+func validateConfig(args []string) int {
+	fs := flag.NewFlagSet("config validate", flag.ExitOnError)
+	var configFile, connectionConfigFile string
+	fs.StringVar(&configFile, "config", "", "YAML or JSON file declaring the adapters and named enforcers created at startup")
+	fs.StringVar(&connectionConfigFile, "connection-config", "", "connection config file, CONNECTION_CONFIG_PATH or config/connection_config.json by default")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: casbin-server config validate [-config file] [-connection-config file]\n")
+		fs.PrintDefaults()
+	}
+	_ = fs.Parse(args)
+	if fs.NArg() > 0 {
+		fs.Usage()
+		return 2
+	}
+	if configFile == "" && connectionConfigFile == "" {
+		connectionConfigFile = server.ConnectionConfigPath()
+	}
+
+	code := 0
+	report := func(path string, err error) {
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			code = 1
+		} else {
+			fmt.Printf("%s: OK\n", path)
+		}
+	}
+	if connectionConfigFile != "" {
+		_, err := server.LoadConnectionConfig(connectionConfigFile)
+		report(connectionConfigFile, err)
+	}
+	if configFile != "" {
+		_, err := server.LoadBootstrapConfig(configFile)
+		report(configFile, err)
+	}
+	return code
+}
+
 func main() {
-	var port int
+	if len(os.Args) > 1 && os.Args[1] == "config" {
+		if len(os.Args) < 3 || os.Args[2] != "validate" {
+			fmt.Fprintln(os.Stderr, "Usage: casbin-server config validate [-config file] [-connection-config file]")
+			os.Exit(2)
+		}
+		os.Exit(validateConfig(os.Args[3:]))
+	}
+
+	var port, httpPort, internalHTTPPort int
+	var certFile, keyFile, clientCAFile, authConfigFile, extAuthzConfigFile, sarConfigFile, tracingConfigFile, auditConfigFile, configFile string
+	var metrics bool
+	var configWatchInterval, shutdownTimeout time.Duration
 	flag.IntVar(&port, "port", 50051, "listening port")
+	flag.StringVar(&configFile, "config", "", "YAML or JSON file declaring the adapters and named enforcers created at startup")
+	flag.DurationVar(&configWatchInterval, "config-watch-interval", 5*time.Second, "how often the config and model files are checked for changes to reload, 0 to only reload on SIGHUP")
+	flag.DurationVar(&shutdownTimeout, "shutdown-timeout", 10*time.Second, "how long the RPCs in flight, such as WatchPolicy streams, are waited for on SIGINT or SIGTERM")
+	flag.IntVar(&httpPort, "http-port", 0, "listening port of the REST/JSON gateway, 0 to disable it")
+	flag.IntVar(&internalHTTPPort, "internal-http-port", 0, "listening port of the metrics endpoint and the SubjectAccessReview webhook, which -auth-config does not cover")
+	flag.StringVar(&certFile, "tls-cert", "", "PEM certificate file, enables TLS")
+	flag.StringVar(&keyFile, "tls-key", "", "PEM private key file of the certificate")
+	flag.StringVar(&clientCAFile, "tls-client-ca", "", "PEM CA file to verify client certificates, enables mutual TLS")
+	flag.StringVar(&authConfigFile, "auth-config", "", "JSON file configuring the authentication and authorization of callers")
+	flag.StringVar(&extAuthzConfigFile, "ext-authz-config", "", "JSON file configuring the Envoy external authorization service")
+	flag.StringVar(&sarConfigFile, "sar-config", "", "JSON file configuring the Kubernetes SubjectAccessReview webhook served on the internal HTTP port")
+	flag.BoolVar(&metrics, "metrics", false, "serve Prometheus metrics at /metrics on the internal HTTP port")
+	flag.StringVar(&tracingConfigFile, "tracing-config", "", "JSON file configuring the export of OpenTelemetry traces")
+	flag.StringVar(&auditConfigFile, "audit-config", "", "JSON file configuring the audit log of decisions and policy changes")
 	flag.Parse()
 
 	if port < 1 || port > 65535 {
 		panic(fmt.Sprintf("invalid port number: %d", port))
 	}
+	if httpPort < 0 || httpPort > 65535 {
+		panic(fmt.Sprintf("invalid port number: %d", httpPort))
+	}
+	if internalHTTPPort < 0 || internalHTTPPort > 65535 {
+		panic(fmt.Sprintf("invalid port number: %d", internalHTTPPort))
+	}
+	if sarConfigFile != "" && internalHTTPPort == 0 {
+		log.Fatalf("the SubjectAccessReview webhook requires -internal-http-port")
+	}
+	if metrics && internalHTTPPort == 0 {
+		log.Fatalf("the metrics endpoint requires -internal-http-port")
+	}
+
+	// The requests fall back to the connection config file, which is optional but must
+	// be valid if it exists.
+	if path := server.ConnectionConfigPath(); fileExists(path) {
+		if _, err := server.LoadConnectionConfig(path); err != nil {
+			log.Fatalf("invalid connection config: %v", err)
+		}
+	}
 
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
 	if err != nil {
 		log.Fatalf("failed to listen: %v", err)
 	}
-	s := grpc.NewServer()
-	pb.RegisterCasbinServer(s, server.NewServer())
+	var tlsConfig *tls.Config
+	if certFile != "" || keyFile != "" || clientCAFile != "" {
+		tlsConfig, err = server.NewTLSConfig(certFile, keyFile, clientCAFile)
+		if err != nil {
+			log.Fatalf("failed to load TLS config: %v", err)
+		}
+	}
+
+	if tracingConfigFile != "" {
+		tracingConfig, err := server.LoadTracingConfig(tracingConfigFile)
+		if err != nil {
+			log.Fatalf("failed to load tracing config: %v", err)
+		}
+		tp, err := server.NewTracerProvider(context.Background(), tracingConfig)
+		if err != nil {
+			log.Fatalf("failed to load tracing config: %v", err)
+		}
+		defer tp.Shutdown(context.Background())
+		otel.SetTracerProvider(tp)
+		otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	}
+
+	srv := server.NewServer()
+	var unaryInterceptors []grpc.UnaryServerInterceptor
+	var streamInterceptors []grpc.StreamServerInterceptor
+	var registry *prometheus.Registry
+	if metrics {
+		registry = prometheus.NewRegistry()
+		registry.MustRegister(collectors.NewGoCollector(), collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}))
+		m, err := server.NewMetrics(srv, registry)
+		if err != nil {
+			log.Fatalf("failed to register metrics: %v", err)
+		}
+		// Outermost, so that the RPCs rejected by the other interceptors are counted too.
+		unaryInterceptors = append(unaryInterceptors, m.UnaryInterceptor)
+		streamInterceptors = append(streamInterceptors, m.StreamInterceptor)
+	}
+	unaryInterceptors = append(unaryInterceptors, server.RecoveryUnaryInterceptor)
+	streamInterceptors = append(streamInterceptors, server.RecoveryStreamInterceptor)
+	if authConfigFile != "" {
+		authConfig, err := server.LoadAuthConfig(authConfigFile)
+		if err != nil {
+			log.Fatalf("failed to load auth config: %v", err)
+		}
+		auth, err := server.NewAuth(authConfig)
+		if err != nil {
+			log.Fatalf("failed to load auth config: %v", err)
+		}
+		unaryInterceptors = append(unaryInterceptors, auth.UnaryInterceptor)
+		streamInterceptors = append(streamInterceptors, auth.StreamInterceptor)
+	}
+	if auditConfigFile != "" {
+		auditConfig, err := server.LoadAuditConfig(auditConfigFile)
+		if err != nil {
+			log.Fatalf("failed to load audit config: %v", err)
+		}
+		audit, err := server.NewAudit(srv, auditConfig)
+		if err != nil {
+			log.Fatalf("failed to load audit config: %v", err)
+		}
+		defer audit.Close()
+		// After auth, so that the changes are recorded with the identity of the caller.
+		unaryInterceptors = append(unaryInterceptors, audit.UnaryInterceptor)
+	}
+	if configFile != "" {
+		reloader := server.NewConfigReloader(srv, configFile)
+		if err := reloader.Reload(context.Background()); err != nil {
+			log.Fatalf("failed to load config: %v", err)
+		}
+		hup := make(chan os.Signal, 1)
+		signal.Notify(hup, syscall.SIGHUP)
+		go reloader.Watch(context.Background(), configWatchInterval, hup)
+	}
+
+	unary := server.ChainUnaryInterceptors(unaryInterceptors...)
+	opts := []grpc.ServerOption{
+		// Traces the RPCs, continuing the trace context sent by the callers.
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.UnaryInterceptor(unary),
+		grpc.ChainStreamInterceptor(streamInterceptors...),
+	}
+	if tlsConfig != nil {
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+	s := grpc.NewServer(opts...)
+	pb.RegisterCasbinServer(s, srv)
+	if extAuthzConfigFile != "" {
+		extAuthzConfig, err := server.LoadExtAuthzConfig(extAuthzConfigFile)
+		if err != nil {
+			log.Fatalf("failed to load ext_authz config: %v", err)
+		}
+		extAuthz, err := server.NewExtAuthz(srv, extAuthzConfig)
+		if err != nil {
+			log.Fatalf("failed to load ext_authz config: %v", err)
+		}
+		authv3.RegisterAuthorizationServer(s, extAuthz)
+	}
 	// Register reflection service on gRPC server.
 	reflection.Register(s)
+
+	var httpServers []*http.Server
+	if httpPort != 0 {
+		mux := http.NewServeMux()
+		mux.Handle("/v1/", server.NewGateway(srv, unary))
+		httpServers = append(httpServers, serveHTTP("Gateway", httpPort, mux, tlsConfig))
+	}
+	// The routes that the auth interceptor does not protect are kept off the gateway port,
+	// so that they can be exposed to Prometheus and kube-apiserver alone.
+	if internalHTTPPort != 0 {
+		mux := http.NewServeMux()
+		if sarConfigFile != "" {
+			sarConfig, err := server.LoadSubjectAccessReviewConfig(sarConfigFile)
+			if err != nil {
+				log.Fatalf("failed to load SubjectAccessReview config: %v", err)
+			}
+			sar, err := server.NewSubjectAccessReview(srv, sarConfig)
+			if err != nil {
+				log.Fatalf("failed to load SubjectAccessReview config: %v", err)
+			}
+			mux.Handle(sar.Path(), sar)
+		}
+		if metrics {
+			mux.Handle("/metrics", promhttp.HandlerFor(registry, promhttp.HandlerOpts{}))
+		}
+		httpServers = append(httpServers, serveHTTP("Internal HTTP server", internalHTTPPort, mux, tlsConfig))
+	}
+
+	// Stop gracefully on SIGINT and SIGTERM, so that Serve returns and the deferred
+	// calls flush the traces and close the audit log.
+	stop := make(chan os.Signal, 1)
+	signal.Notify(stop, syscall.SIGINT, syscall.SIGTERM)
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		log.Printf("received %v, stopping", <-stop)
+		ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		// Stops the streams left when the timeout expires.
+		timer := time.AfterFunc(shutdownTimeout, s.Stop)
+		defer timer.Stop()
+		for _, h := range httpServers {
+			if err := h.Shutdown(ctx); err != nil {
+				log.Printf("failed to shut down HTTP server: %v", err)
+			}
+		}
+		s.GracefulStop()
+	}()
+
 	log.Println("Listening on", port)
 	if err := s.Serve(lis); err != nil {
 		log.Fatalf("failed to serve: %v", err)
 	}
+	// Serve returns as soon as it stops accepting connections, wait for the RPCs in flight.
+	<-stopped
+}
+
+// serveHTTP serves handler on port in the background, over TLS if tlsConfig is not nil.
+func serveHTTP(name string, port int, handler http.Handler, tlsConfig *tls.Config) *http.Server {
+	log.Println(name, "listening on", port)
+	srv := &http.Server{
+		Addr:      fmt.Sprintf(":%d", port),
+		Handler:   handler,
+		TLSConfig: tlsConfig,
+	}
+	go func() {
+		var err error
+		if tlsConfig != nil {
+			err = srv.ListenAndServeTLS("", "")
+		} else {
+			err = srv.ListenAndServe()
+		}
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Fatalf("failed to serve %s: %v", strings.ToLower(name), err)
+		}
+	}()
+	return srv
+}
+
+func fileExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
 }
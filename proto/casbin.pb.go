@@ -23,6 +23,7 @@ package proto
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -34,6 +35,61 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type PolicyEventType int32
+
+const (
+	PolicyEvent_ADD    PolicyEventType = 0
+	PolicyEvent_REMOVE PolicyEventType = 1
+	PolicyEvent_UPDATE PolicyEventType = 2
+	PolicyEvent_LOAD   PolicyEventType = 3
+	PolicyEvent_SAVE   PolicyEventType = 4
+)
+
+// Enum value maps for PolicyEventType.
+var (
+	PolicyEventType_name = map[int32]string{
+		0: "ADD",
+		1: "REMOVE",
+		2: "UPDATE",
+		3: "LOAD",
+		4: "SAVE",
+	}
+	PolicyEventType_value = map[string]int32{
+		"ADD":    0,
+		"REMOVE": 1,
+		"UPDATE": 2,
+		"LOAD":   3,
+		"SAVE":   4,
+	}
+)
+
+func (x PolicyEventType) Enum() *PolicyEventType {
+	p := new(PolicyEventType)
+	*p = x
+	return p
+}
+
+func (x PolicyEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PolicyEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_casbin_proto_enumTypes[0].Descriptor()
+}
+
+func (PolicyEventType) Type() protoreflect.EnumType {
+	return &file_proto_casbin_proto_enumTypes[0]
+}
+
+func (x PolicyEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PolicyEventType.Descriptor instead.
+func (PolicyEventType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_casbin_proto_rawDescGZIP(), []int{18, 0}
+}
+
 type NewEnforcerRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -42,6 +98,14 @@ type NewEnforcerRequest struct {
 	ModelText               string `protobuf:"bytes,1,opt,name=modelText,proto3" json:"modelText,omitempty"`
 	AdapterHandle           int32  `protobuf:"varint,2,opt,name=adapterHandle,proto3" json:"adapterHandle,omitempty"`
 	EnableAcceptJsonRequest bool   `protobuf:"varint,3,opt,name=enableAcceptJsonRequest,proto3" json:"enableAcceptJsonRequest,omitempty"`
+	Name                    string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	GetOrCreate             bool   `protobuf:"varint,5,opt,name=getOrCreate,proto3" json:"getOrCreate,omitempty"`
+	// watcherDriverName keeps the policy of the named enforcer in sync with the
+	// enforcers of the same name on other servers. Currently supported: redis.
+	WatcherDriverName    string `protobuf:"bytes,6,opt,name=watcherDriverName,proto3" json:"watcherDriverName,omitempty"`
+	WatcherConnectString string `protobuf:"bytes,7,opt,name=watcherConnectString,proto3" json:"watcherConnectString,omitempty"`
+	// enableCache caches the decisions of the enforcer until its policy changes.
+	EnableCache bool `protobuf:"varint,8,opt,name=enableCache,proto3" json:"enableCache,omitempty"`
 }
 
 func (x *NewEnforcerRequest) Reset() {
@@ -97,6 +161,41 @@ func (x *NewEnforcerRequest) GetEnableAcceptJsonRequest() bool {
 	return false
 }
 
+func (x *NewEnforcerRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NewEnforcerRequest) GetGetOrCreate() bool {
+	if x != nil {
+		return x.GetOrCreate
+	}
+	return false
+}
+
+func (x *NewEnforcerRequest) GetWatcherDriverName() string {
+	if x != nil {
+		return x.WatcherDriverName
+	}
+	return ""
+}
+
+func (x *NewEnforcerRequest) GetWatcherConnectString() string {
+	if x != nil {
+		return x.WatcherConnectString
+	}
+	return ""
+}
+
+func (x *NewEnforcerRequest) GetEnableCache() bool {
+	if x != nil {
+		return x.EnableCache
+	}
+	return false
+}
+
 type NewEnforcerReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -144,6 +243,100 @@ func (x *NewEnforcerReply) GetHandler() int32 {
 	return 0
 }
 
+type EnforcerNameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *EnforcerNameRequest) Reset() {
+	*x = EnforcerNameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_casbin_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnforcerNameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnforcerNameRequest) ProtoMessage() {}
+
+func (x *EnforcerNameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_casbin_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnforcerNameRequest.ProtoReflect.Descriptor instead.
+func (*EnforcerNameRequest) Descriptor() ([]byte, []int) {
+	return file_proto_casbin_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EnforcerNameRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type EnforcerListReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enforcers []*EnforcerListReplyEnforcer `protobuf:"bytes,1,rep,name=enforcers,proto3" json:"enforcers,omitempty"`
+}
+
+func (x *EnforcerListReply) Reset() {
+	*x = EnforcerListReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_casbin_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnforcerListReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnforcerListReply) ProtoMessage() {}
+
+func (x *EnforcerListReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_casbin_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnforcerListReply.ProtoReflect.Descriptor instead.
+func (*EnforcerListReply) Descriptor() ([]byte, []int) {
+	return file_proto_casbin_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *EnforcerListReply) GetEnforcers() []*EnforcerListReplyEnforcer {
+	if x != nil {
+		return x.Enforcers
+	}
+	return nil
+}
+
 type NewAdapterRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -158,7 +351,7 @@ type NewAdapterRequest struct {
 func (x *NewAdapterRequest) Reset() {
 	*x = NewAdapterRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_casbin_proto_msgTypes[2]
+		mi := &file_proto_casbin_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -171,7 +364,7 @@ func (x *NewAdapterRequest) String() string {
 func (*NewAdapterRequest) ProtoMessage() {}
 
 func (x *NewAdapterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_casbin_proto_msgTypes[2]
+	mi := &file_proto_casbin_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -184,7 +377,7 @@ func (x *NewAdapterRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NewAdapterRequest.ProtoReflect.Descriptor instead.
 func (*NewAdapterRequest) Descriptor() ([]byte, []int) {
-	return file_proto_casbin_proto_rawDescGZIP(), []int{2}
+	return file_proto_casbin_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *NewAdapterRequest) GetAdapterName() string {
@@ -226,7 +419,7 @@ type NewAdapterReply struct {
 func (x *NewAdapterReply) Reset() {
 	*x = NewAdapterReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_casbin_proto_msgTypes[3]
+		mi := &file_proto_casbin_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -239,7 +432,7 @@ func (x *NewAdapterReply) String() string {
 func (*NewAdapterReply) ProtoMessage() {}
 
 func (x *NewAdapterReply) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_casbin_proto_msgTypes[3]
+	mi := &file_proto_casbin_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -252,7 +445,7 @@ func (x *NewAdapterReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NewAdapterReply.ProtoReflect.Descriptor instead.
 func (*NewAdapterReply) Descriptor() ([]byte, []int) {
-	return file_proto_casbin_proto_rawDescGZIP(), []int{3}
+	return file_proto_casbin_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *NewAdapterReply) GetHandler() int32 {
@@ -269,12 +462,15 @@ type EnforceRequest struct {
 
 	EnforcerHandler int32    `protobuf:"varint,1,opt,name=enforcerHandler,proto3" json:"enforcerHandler,omitempty"`
 	Params          []string `protobuf:"bytes,2,rep,name=params,proto3" json:"params,omitempty"`
+	// Typed params, used instead of params when set. Struct values are passed to the
+	// enforcer as objects, so their attributes can be used in the matcher.
+	Values []*structpb.Value `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
 }
 
 func (x *EnforceRequest) Reset() {
 	*x = EnforceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_casbin_proto_msgTypes[4]
+		mi := &file_proto_casbin_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -287,7 +483,7 @@ func (x *EnforceRequest) String() string {
 func (*EnforceRequest) ProtoMessage() {}
 
 func (x *EnforceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_casbin_proto_msgTypes[4]
+	mi := &file_proto_casbin_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -300,7 +496,7 @@ func (x *EnforceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnforceRequest.ProtoReflect.Descriptor instead.
 func (*EnforceRequest) Descriptor() ([]byte, []int) {
-	return file_proto_casbin_proto_rawDescGZIP(), []int{4}
+	return file_proto_casbin_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *EnforceRequest) GetEnforcerHandler() int32 {
@@ -317,31 +513,39 @@ func (x *EnforceRequest) GetParams() []string {
 	return nil
 }
 
-type BoolReply struct {
+func (x *EnforceRequest) GetValues() []*structpb.Value {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type BatchEnforceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Res bool `protobuf:"varint,1,opt,name=res,proto3" json:"res,omitempty"`
+	EnforcerHandler int32                         `protobuf:"varint,1,opt,name=enforcerHandler,proto3" json:"enforcerHandler,omitempty"`
+	Requests        []*BatchEnforceRequestRequest `protobuf:"bytes,2,rep,name=requests,proto3" json:"requests,omitempty"`
 }
 
-func (x *BoolReply) Reset() {
-	*x = BoolReply{}
+func (x *BatchEnforceRequest) Reset() {
+	*x = BatchEnforceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_casbin_proto_msgTypes[5]
+		mi := &file_proto_casbin_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BoolReply) String() string {
+func (x *BatchEnforceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BoolReply) ProtoMessage() {}
+func (*BatchEnforceRequest) ProtoMessage() {}
 
-func (x *BoolReply) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_casbin_proto_msgTypes[5]
+func (x *BatchEnforceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_casbin_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -352,43 +556,50 @@ func (x *BoolReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BoolReply.ProtoReflect.Descriptor instead.
-func (*BoolReply) Descriptor() ([]byte, []int) {
-	return file_proto_casbin_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use BatchEnforceRequest.ProtoReflect.Descriptor instead.
+func (*BatchEnforceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_casbin_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *BoolReply) GetRes() bool {
+func (x *BatchEnforceRequest) GetEnforcerHandler() int32 {
 	if x != nil {
-		return x.Res
+		return x.EnforcerHandler
 	}
-	return false
+	return 0
 }
 
-type EmptyRequest struct {
+func (x *BatchEnforceRequest) GetRequests() []*BatchEnforceRequestRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+type BoolReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Handler int32 `protobuf:"varint,1,opt,name=handler,proto3" json:"handler,omitempty"`
+	Res bool `protobuf:"varint,1,opt,name=res,proto3" json:"res,omitempty"`
 }
 
-func (x *EmptyRequest) Reset() {
-	*x = EmptyRequest{}
+func (x *BoolReply) Reset() {
+	*x = BoolReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_casbin_proto_msgTypes[6]
+		mi := &file_proto_casbin_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EmptyRequest) String() string {
+func (x *BoolReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EmptyRequest) ProtoMessage() {}
+func (*BoolReply) ProtoMessage() {}
 
-func (x *EmptyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_casbin_proto_msgTypes[6]
+func (x *BoolReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_casbin_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -399,41 +610,43 @@ func (x *EmptyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EmptyRequest.ProtoReflect.Descriptor instead.
-func (*EmptyRequest) Descriptor() ([]byte, []int) {
-	return file_proto_casbin_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use BoolReply.ProtoReflect.Descriptor instead.
+func (*BoolReply) Descriptor() ([]byte, []int) {
+	return file_proto_casbin_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *EmptyRequest) GetHandler() int32 {
+func (x *BoolReply) GetRes() bool {
 	if x != nil {
-		return x.Handler
+		return x.Res
 	}
-	return 0
+	return false
 }
 
-type EmptyReply struct {
+type BatchBoolReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Res []bool `protobuf:"varint,1,rep,packed,name=res,proto3" json:"res,omitempty"`
 }
 
-func (x *EmptyReply) Reset() {
-	*x = EmptyReply{}
+func (x *BatchBoolReply) Reset() {
+	*x = BatchBoolReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_casbin_proto_msgTypes[7]
+		mi := &file_proto_casbin_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EmptyReply) String() string {
+func (x *BatchBoolReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EmptyReply) ProtoMessage() {}
+func (*BatchBoolReply) ProtoMessage() {}
 
-func (x *EmptyReply) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_casbin_proto_msgTypes[7]
+func (x *BatchBoolReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_casbin_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -444,38 +657,44 @@ func (x *EmptyReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EmptyReply.ProtoReflect.Descriptor instead.
-func (*EmptyReply) Descriptor() ([]byte, []int) {
-	return file_proto_casbin_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use BatchBoolReply.ProtoReflect.Descriptor instead.
+func (*BatchBoolReply) Descriptor() ([]byte, []int) {
+	return file_proto_casbin_proto_rawDescGZIP(), []int{9}
 }
 
-type PolicyRequest struct {
+func (x *BatchBoolReply) GetRes() []bool {
+	if x != nil {
+		return x.Res
+	}
+	return nil
+}
+
+type EnforceExReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	EnforcerHandler int32    `protobuf:"varint,1,opt,name=enforcerHandler,proto3" json:"enforcerHandler,omitempty"`
-	PType           string   `protobuf:"bytes,2,opt,name=pType,proto3" json:"pType,omitempty"`
-	Params          []string `protobuf:"bytes,3,rep,name=params,proto3" json:"params,omitempty"`
+	Res     bool     `protobuf:"varint,1,opt,name=res,proto3" json:"res,omitempty"`
+	Explain []string `protobuf:"bytes,2,rep,name=explain,proto3" json:"explain,omitempty"`
 }
 
-func (x *PolicyRequest) Reset() {
-	*x = PolicyRequest{}
+func (x *EnforceExReply) Reset() {
+	*x = EnforceExReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_casbin_proto_msgTypes[8]
+		mi := &file_proto_casbin_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PolicyRequest) String() string {
+func (x *EnforceExReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PolicyRequest) ProtoMessage() {}
+func (*EnforceExReply) ProtoMessage() {}
 
-func (x *PolicyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_casbin_proto_msgTypes[8]
+func (x *EnforceExReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_casbin_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -486,58 +705,50 @@ func (x *PolicyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PolicyRequest.ProtoReflect.Descriptor instead.
-func (*PolicyRequest) Descriptor() ([]byte, []int) {
-	return file_proto_casbin_proto_rawDescGZIP(), []int{8}
-}
-
-func (x *PolicyRequest) GetEnforcerHandler() int32 {
-	if x != nil {
-		return x.EnforcerHandler
-	}
-	return 0
+// Deprecated: Use EnforceExReply.ProtoReflect.Descriptor instead.
+func (*EnforceExReply) Descriptor() ([]byte, []int) {
+	return file_proto_casbin_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *PolicyRequest) GetPType() string {
+func (x *EnforceExReply) GetRes() bool {
 	if x != nil {
-		return x.PType
+		return x.Res
 	}
-	return ""
+	return false
 }
 
-func (x *PolicyRequest) GetParams() []string {
+func (x *EnforceExReply) GetExplain() []string {
 	if x != nil {
-		return x.Params
+		return x.Explain
 	}
 	return nil
 }
 
-type SimpleGetRequest struct {
+type EmptyRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	EnforcerHandler int32  `protobuf:"varint,1,opt,name=enforcerHandler,proto3" json:"enforcerHandler,omitempty"`
-	PType           string `protobuf:"bytes,2,opt,name=pType,proto3" json:"pType,omitempty"`
+	Handler int32 `protobuf:"varint,1,opt,name=handler,proto3" json:"handler,omitempty"`
 }
 
-func (x *SimpleGetRequest) Reset() {
-	*x = SimpleGetRequest{}
+func (x *EmptyRequest) Reset() {
+	*x = EmptyRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_casbin_proto_msgTypes[9]
+		mi := &file_proto_casbin_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SimpleGetRequest) String() string {
+func (x *EmptyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SimpleGetRequest) ProtoMessage() {}
+func (*EmptyRequest) ProtoMessage() {}
 
-func (x *SimpleGetRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_casbin_proto_msgTypes[9]
+func (x *EmptyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_casbin_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -548,50 +759,83 @@ func (x *SimpleGetRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SimpleGetRequest.ProtoReflect.Descriptor instead.
-func (*SimpleGetRequest) Descriptor() ([]byte, []int) {
-	return file_proto_casbin_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use EmptyRequest.ProtoReflect.Descriptor instead.
+func (*EmptyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_casbin_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *SimpleGetRequest) GetEnforcerHandler() int32 {
+func (x *EmptyRequest) GetHandler() int32 {
 	if x != nil {
-		return x.EnforcerHandler
+		return x.Handler
 	}
 	return 0
 }
 
-func (x *SimpleGetRequest) GetPType() string {
-	if x != nil {
-		return x.PType
+type EmptyReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *EmptyReply) Reset() {
+	*x = EmptyReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_casbin_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-type ArrayReply struct {
+func (x *EmptyReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmptyReply) ProtoMessage() {}
+
+func (x *EmptyReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_casbin_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmptyReply.ProtoReflect.Descriptor instead.
+func (*EmptyReply) Descriptor() ([]byte, []int) {
+	return file_proto_casbin_proto_rawDescGZIP(), []int{12}
+}
+
+type PolicyRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Array []string `protobuf:"bytes,1,rep,name=array,proto3" json:"array,omitempty"`
+	EnforcerHandler int32    `protobuf:"varint,1,opt,name=enforcerHandler,proto3" json:"enforcerHandler,omitempty"`
+	PType           string   `protobuf:"bytes,2,opt,name=pType,proto3" json:"pType,omitempty"`
+	Params          []string `protobuf:"bytes,3,rep,name=params,proto3" json:"params,omitempty"`
 }
 
-func (x *ArrayReply) Reset() {
-	*x = ArrayReply{}
+func (x *PolicyRequest) Reset() {
+	*x = PolicyRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_casbin_proto_msgTypes[10]
+		mi := &file_proto_casbin_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ArrayReply) String() string {
+func (x *PolicyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ArrayReply) ProtoMessage() {}
+func (*PolicyRequest) ProtoMessage() {}
 
-func (x *ArrayReply) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_casbin_proto_msgTypes[10]
+func (x *PolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_casbin_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -602,46 +846,59 @@ func (x *ArrayReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ArrayReply.ProtoReflect.Descriptor instead.
-func (*ArrayReply) Descriptor() ([]byte, []int) {
-	return file_proto_casbin_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use PolicyRequest.ProtoReflect.Descriptor instead.
+func (*PolicyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_casbin_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *ArrayReply) GetArray() []string {
+func (x *PolicyRequest) GetEnforcerHandler() int32 {
 	if x != nil {
-		return x.Array
+		return x.EnforcerHandler
+	}
+	return 0
+}
+
+func (x *PolicyRequest) GetPType() string {
+	if x != nil {
+		return x.PType
+	}
+	return ""
+}
+
+func (x *PolicyRequest) GetParams() []string {
+	if x != nil {
+		return x.Params
 	}
 	return nil
 }
 
-type FilteredPolicyRequest struct {
+type PoliciesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	EnforcerHandler int32    `protobuf:"varint,1,opt,name=enforcerHandler,proto3" json:"enforcerHandler,omitempty"`
-	PType           string   `protobuf:"bytes,2,opt,name=pType,proto3" json:"pType,omitempty"`
-	FieldIndex      int32    `protobuf:"varint,3,opt,name=fieldIndex,proto3" json:"fieldIndex,omitempty"`
-	FieldValues     []string `protobuf:"bytes,4,rep,name=fieldValues,proto3" json:"fieldValues,omitempty"`
+	EnforcerHandler int32                  `protobuf:"varint,1,opt,name=enforcerHandler,proto3" json:"enforcerHandler,omitempty"`
+	PType           string                 `protobuf:"bytes,2,opt,name=pType,proto3" json:"pType,omitempty"`
+	Rules           []*PoliciesRequestRule `protobuf:"bytes,3,rep,name=rules,proto3" json:"rules,omitempty"`
 }
 
-func (x *FilteredPolicyRequest) Reset() {
-	*x = FilteredPolicyRequest{}
+func (x *PoliciesRequest) Reset() {
+	*x = PoliciesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_casbin_proto_msgTypes[11]
+		mi := &file_proto_casbin_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FilteredPolicyRequest) String() string {
+func (x *PoliciesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FilteredPolicyRequest) ProtoMessage() {}
+func (*PoliciesRequest) ProtoMessage() {}
 
-func (x *FilteredPolicyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_casbin_proto_msgTypes[11]
+func (x *PoliciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_casbin_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -652,67 +909,60 @@ func (x *FilteredPolicyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FilteredPolicyRequest.ProtoReflect.Descriptor instead.
-func (*FilteredPolicyRequest) Descriptor() ([]byte, []int) {
-	return file_proto_casbin_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use PoliciesRequest.ProtoReflect.Descriptor instead.
+func (*PoliciesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_casbin_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *FilteredPolicyRequest) GetEnforcerHandler() int32 {
+func (x *PoliciesRequest) GetEnforcerHandler() int32 {
 	if x != nil {
 		return x.EnforcerHandler
 	}
 	return 0
 }
 
-func (x *FilteredPolicyRequest) GetPType() string {
+func (x *PoliciesRequest) GetPType() string {
 	if x != nil {
 		return x.PType
 	}
 	return ""
 }
 
-func (x *FilteredPolicyRequest) GetFieldIndex() int32 {
-	if x != nil {
-		return x.FieldIndex
-	}
-	return 0
-}
-
-func (x *FilteredPolicyRequest) GetFieldValues() []string {
+func (x *PoliciesRequest) GetRules() []*PoliciesRequestRule {
 	if x != nil {
-		return x.FieldValues
+		return x.Rules
 	}
 	return nil
 }
 
-type UserRoleRequest struct {
+type UpdatePolicyRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	EnforcerHandler int32    `protobuf:"varint,1,opt,name=enforcerHandler,proto3" json:"enforcerHandler,omitempty"`
-	User            string   `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
-	Role            string   `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
-	Domain          []string `protobuf:"bytes,4,rep,name=domain,proto3" json:"domain,omitempty"`
+	PType           string   `protobuf:"bytes,2,opt,name=pType,proto3" json:"pType,omitempty"`
+	OldRule         []string `protobuf:"bytes,3,rep,name=oldRule,proto3" json:"oldRule,omitempty"`
+	NewRule         []string `protobuf:"bytes,4,rep,name=newRule,proto3" json:"newRule,omitempty"`
 }
 
-func (x *UserRoleRequest) Reset() {
-	*x = UserRoleRequest{}
+func (x *UpdatePolicyRequest) Reset() {
+	*x = UpdatePolicyRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_casbin_proto_msgTypes[12]
+		mi := &file_proto_casbin_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UserRoleRequest) String() string {
+func (x *UpdatePolicyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserRoleRequest) ProtoMessage() {}
+func (*UpdatePolicyRequest) ProtoMessage() {}
 
-func (x *UserRoleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_casbin_proto_msgTypes[12]
+func (x *UpdatePolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_casbin_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -723,67 +973,67 @@ func (x *UserRoleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserRoleRequest.ProtoReflect.Descriptor instead.
-func (*UserRoleRequest) Descriptor() ([]byte, []int) {
-	return file_proto_casbin_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use UpdatePolicyRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePolicyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_casbin_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *UserRoleRequest) GetEnforcerHandler() int32 {
+func (x *UpdatePolicyRequest) GetEnforcerHandler() int32 {
 	if x != nil {
 		return x.EnforcerHandler
 	}
 	return 0
 }
 
-func (x *UserRoleRequest) GetUser() string {
+func (x *UpdatePolicyRequest) GetPType() string {
 	if x != nil {
-		return x.User
+		return x.PType
 	}
 	return ""
 }
 
-func (x *UserRoleRequest) GetRole() string {
+func (x *UpdatePolicyRequest) GetOldRule() []string {
 	if x != nil {
-		return x.Role
+		return x.OldRule
 	}
-	return ""
+	return nil
 }
 
-func (x *UserRoleRequest) GetDomain() []string {
+func (x *UpdatePolicyRequest) GetNewRule() []string {
 	if x != nil {
-		return x.Domain
+		return x.NewRule
 	}
 	return nil
 }
 
-type PermissionRequest struct {
+type UpdatePoliciesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	EnforcerHandler int32    `protobuf:"varint,1,opt,name=enforcerHandler,proto3" json:"enforcerHandler,omitempty"`
-	User            string   `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
-	Permissions     []string `protobuf:"bytes,3,rep,name=permissions,proto3" json:"permissions,omitempty"`
-	Domain          []string `protobuf:"bytes,4,rep,name=domain,proto3" json:"domain,omitempty"`
+	EnforcerHandler int32                  `protobuf:"varint,1,opt,name=enforcerHandler,proto3" json:"enforcerHandler,omitempty"`
+	PType           string                 `protobuf:"bytes,2,opt,name=pType,proto3" json:"pType,omitempty"`
+	OldRules        []*PoliciesRequestRule `protobuf:"bytes,3,rep,name=oldRules,proto3" json:"oldRules,omitempty"`
+	NewRules        []*PoliciesRequestRule `protobuf:"bytes,4,rep,name=newRules,proto3" json:"newRules,omitempty"`
 }
 
-func (x *PermissionRequest) Reset() {
-	*x = PermissionRequest{}
+func (x *UpdatePoliciesRequest) Reset() {
+	*x = UpdatePoliciesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_casbin_proto_msgTypes[13]
+		mi := &file_proto_casbin_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PermissionRequest) String() string {
+func (x *UpdatePoliciesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PermissionRequest) ProtoMessage() {}
+func (*UpdatePoliciesRequest) ProtoMessage() {}
 
-func (x *PermissionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_casbin_proto_msgTypes[13]
+func (x *UpdatePoliciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_casbin_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -794,64 +1044,68 @@ func (x *PermissionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PermissionRequest.ProtoReflect.Descriptor instead.
-func (*PermissionRequest) Descriptor() ([]byte, []int) {
-	return file_proto_casbin_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use UpdatePoliciesRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePoliciesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_casbin_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *PermissionRequest) GetEnforcerHandler() int32 {
+func (x *UpdatePoliciesRequest) GetEnforcerHandler() int32 {
 	if x != nil {
 		return x.EnforcerHandler
 	}
 	return 0
 }
 
-func (x *PermissionRequest) GetUser() string {
+func (x *UpdatePoliciesRequest) GetPType() string {
 	if x != nil {
-		return x.User
+		return x.PType
 	}
 	return ""
 }
 
-func (x *PermissionRequest) GetPermissions() []string {
+func (x *UpdatePoliciesRequest) GetOldRules() []*PoliciesRequestRule {
 	if x != nil {
-		return x.Permissions
+		return x.OldRules
 	}
 	return nil
 }
 
-func (x *PermissionRequest) GetDomain() []string {
+func (x *UpdatePoliciesRequest) GetNewRules() []*PoliciesRequestRule {
 	if x != nil {
-		return x.Domain
+		return x.NewRules
 	}
 	return nil
 }
 
-type Array2DReply struct {
+type UpdateFilteredPoliciesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	D2 []*Array2DReplyD `protobuf:"bytes,1,rep,name=d2,proto3" json:"d2,omitempty"`
+	EnforcerHandler int32                  `protobuf:"varint,1,opt,name=enforcerHandler,proto3" json:"enforcerHandler,omitempty"`
+	PType           string                 `protobuf:"bytes,2,opt,name=pType,proto3" json:"pType,omitempty"`
+	NewRules        []*PoliciesRequestRule `protobuf:"bytes,3,rep,name=newRules,proto3" json:"newRules,omitempty"`
+	FieldIndex      int32                  `protobuf:"varint,4,opt,name=fieldIndex,proto3" json:"fieldIndex,omitempty"`
+	FieldValues     []string               `protobuf:"bytes,5,rep,name=fieldValues,proto3" json:"fieldValues,omitempty"`
 }
 
-func (x *Array2DReply) Reset() {
-	*x = Array2DReply{}
+func (x *UpdateFilteredPoliciesRequest) Reset() {
+	*x = UpdateFilteredPoliciesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_casbin_proto_msgTypes[14]
+		mi := &file_proto_casbin_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Array2DReply) String() string {
+func (x *UpdateFilteredPoliciesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Array2DReply) ProtoMessage() {}
+func (*UpdateFilteredPoliciesRequest) ProtoMessage() {}
 
-func (x *Array2DReply) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_casbin_proto_msgTypes[14]
+func (x *UpdateFilteredPoliciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_casbin_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -862,43 +1116,77 @@ func (x *Array2DReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Array2DReply.ProtoReflect.Descriptor instead.
-func (*Array2DReply) Descriptor() ([]byte, []int) {
-	return file_proto_casbin_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use UpdateFilteredPoliciesRequest.ProtoReflect.Descriptor instead.
+func (*UpdateFilteredPoliciesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_casbin_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *Array2DReply) GetD2() []*Array2DReplyD {
+func (x *UpdateFilteredPoliciesRequest) GetEnforcerHandler() int32 {
 	if x != nil {
-		return x.D2
+		return x.EnforcerHandler
+	}
+	return 0
+}
+
+func (x *UpdateFilteredPoliciesRequest) GetPType() string {
+	if x != nil {
+		return x.PType
+	}
+	return ""
+}
+
+func (x *UpdateFilteredPoliciesRequest) GetNewRules() []*PoliciesRequestRule {
+	if x != nil {
+		return x.NewRules
 	}
 	return nil
 }
 
-type Array2DReplyD struct {
+func (x *UpdateFilteredPoliciesRequest) GetFieldIndex() int32 {
+	if x != nil {
+		return x.FieldIndex
+	}
+	return 0
+}
+
+func (x *UpdateFilteredPoliciesRequest) GetFieldValues() []string {
+	if x != nil {
+		return x.FieldValues
+	}
+	return nil
+}
+
+type PolicyEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	D1 []string `protobuf:"bytes,1,rep,name=d1,proto3" json:"d1,omitempty"`
+	EventType PolicyEventType `protobuf:"varint,1,opt,name=eventType,proto3,enum=proto.PolicyEventType" json:"eventType,omitempty"`
+	Sec       string          `protobuf:"bytes,2,opt,name=sec,proto3" json:"sec,omitempty"`
+	PType     string          `protobuf:"bytes,3,opt,name=pType,proto3" json:"pType,omitempty"`
+	// The rules that were added or removed, or the new rules of an update.
+	Rules []*PoliciesRequestRule `protobuf:"bytes,4,rep,name=rules,proto3" json:"rules,omitempty"`
+	// The old rules of an update, in the order of rules.
+	OldRules []*PoliciesRequestRule `protobuf:"bytes,5,rep,name=oldRules,proto3" json:"oldRules,omitempty"`
 }
 
-func (x *Array2DReplyD) Reset() {
-	*x = Array2DReplyD{}
+func (x *PolicyEvent) Reset() {
+	*x = PolicyEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_casbin_proto_msgTypes[15]
+		mi := &file_proto_casbin_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Array2DReplyD) String() string {
+func (x *PolicyEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Array2DReplyD) ProtoMessage() {}
+func (*PolicyEvent) ProtoMessage() {}
 
-func (x *Array2DReplyD) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_casbin_proto_msgTypes[15]
+func (x *PolicyEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_casbin_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -909,65 +1197,770 @@ func (x *Array2DReplyD) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Array2DReplyD.ProtoReflect.Descriptor instead.
-func (*Array2DReplyD) Descriptor() ([]byte, []int) {
-	return file_proto_casbin_proto_rawDescGZIP(), []int{14, 0}
+// Deprecated: Use PolicyEvent.ProtoReflect.Descriptor instead.
+func (*PolicyEvent) Descriptor() ([]byte, []int) {
+	return file_proto_casbin_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *Array2DReplyD) GetD1() []string {
+func (x *PolicyEvent) GetEventType() PolicyEventType {
 	if x != nil {
-		return x.D1
+		return x.EventType
+	}
+	return PolicyEvent_ADD
+}
+
+func (x *PolicyEvent) GetSec() string {
+	if x != nil {
+		return x.Sec
+	}
+	return ""
+}
+
+func (x *PolicyEvent) GetPType() string {
+	if x != nil {
+		return x.PType
+	}
+	return ""
+}
+
+func (x *PolicyEvent) GetRules() []*PoliciesRequestRule {
+	if x != nil {
+		return x.Rules
 	}
 	return nil
 }
 
-var File_proto_casbin_proto protoreflect.FileDescriptor
+func (x *PolicyEvent) GetOldRules() []*PoliciesRequestRule {
+	if x != nil {
+		return x.OldRules
+	}
+	return nil
+}
 
-var file_proto_casbin_proto_rawDesc = []byte{
-	0x0a, 0x12, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x61, 0x73, 0x62, 0x69, 0x6e, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x92, 0x01, 0x0a, 0x12,
-	0x4e, 0x65, 0x77, 0x45, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x54, 0x65, 0x78, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x54, 0x65, 0x78, 0x74,
-	0x12, 0x24, 0x0a, 0x0d, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x48, 0x61, 0x6e, 0x64, 0x6c,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72,
-	0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x38, 0x0a, 0x17, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
-	0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x4a, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x17, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x41,
-	0x63, 0x63, 0x65, 0x70, 0x74, 0x4a, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x22, 0x2c, 0x0a, 0x10, 0x4e, 0x65, 0x77, 0x45, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x72, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x22, 0x9d,
-	0x01, 0x0a, 0x11, 0x4e, 0x65, 0x77, 0x41, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x4e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x64, 0x61, 0x70, 0x74,
-	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72,
-	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x72, 0x69, 0x76,
-	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
-	0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63,
-	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x20, 0x0a, 0x0b,
-	0x64, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x0b, 0x64, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x22, 0x2b,
-	0x0a, 0x0f, 0x4e, 0x65, 0x77, 0x41, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x07, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x22, 0x52, 0x0a, 0x0e, 0x45,
-	0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a,
-	0x0f, 0x65, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x72, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x65, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x72,
-	0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22,
-	0x1d, 0x0a, 0x09, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x10, 0x0a, 0x03,
-	0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x72, 0x65, 0x73, 0x22, 0x28,
-	0x0a, 0x0c, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18,
-	0x0a, 0x07, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x07, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x22, 0x0c, 0x0a, 0x0a, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x67, 0x0a, 0x0d, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x65, 0x6e, 0x66, 0x6f, 0x72,
-	0x63, 0x65, 0x72, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x0f, 0x65, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x72, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65,
-	0x72, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x70, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22,
+type SimpleGetRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EnforcerHandler int32  `protobuf:"varint,1,opt,name=enforcerHandler,proto3" json:"enforcerHandler,omitempty"`
+	PType           string `protobuf:"bytes,2,opt,name=pType,proto3" json:"pType,omitempty"`
+}
+
+func (x *SimpleGetRequest) Reset() {
+	*x = SimpleGetRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_casbin_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SimpleGetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimpleGetRequest) ProtoMessage() {}
+
+func (x *SimpleGetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_casbin_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimpleGetRequest.ProtoReflect.Descriptor instead.
+func (*SimpleGetRequest) Descriptor() ([]byte, []int) {
+	return file_proto_casbin_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SimpleGetRequest) GetEnforcerHandler() int32 {
+	if x != nil {
+		return x.EnforcerHandler
+	}
+	return 0
+}
+
+func (x *SimpleGetRequest) GetPType() string {
+	if x != nil {
+		return x.PType
+	}
+	return ""
+}
+
+type ArrayReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Array []string `protobuf:"bytes,1,rep,name=array,proto3" json:"array,omitempty"`
+}
+
+func (x *ArrayReply) Reset() {
+	*x = ArrayReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_casbin_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArrayReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArrayReply) ProtoMessage() {}
+
+func (x *ArrayReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_casbin_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArrayReply.ProtoReflect.Descriptor instead.
+func (*ArrayReply) Descriptor() ([]byte, []int) {
+	return file_proto_casbin_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ArrayReply) GetArray() []string {
+	if x != nil {
+		return x.Array
+	}
+	return nil
+}
+
+type FilteredPolicyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EnforcerHandler int32    `protobuf:"varint,1,opt,name=enforcerHandler,proto3" json:"enforcerHandler,omitempty"`
+	PType           string   `protobuf:"bytes,2,opt,name=pType,proto3" json:"pType,omitempty"`
+	FieldIndex      int32    `protobuf:"varint,3,opt,name=fieldIndex,proto3" json:"fieldIndex,omitempty"`
+	FieldValues     []string `protobuf:"bytes,4,rep,name=fieldValues,proto3" json:"fieldValues,omitempty"`
+}
+
+func (x *FilteredPolicyRequest) Reset() {
+	*x = FilteredPolicyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_casbin_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FilteredPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FilteredPolicyRequest) ProtoMessage() {}
+
+func (x *FilteredPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_casbin_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FilteredPolicyRequest.ProtoReflect.Descriptor instead.
+func (*FilteredPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_casbin_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *FilteredPolicyRequest) GetEnforcerHandler() int32 {
+	if x != nil {
+		return x.EnforcerHandler
+	}
+	return 0
+}
+
+func (x *FilteredPolicyRequest) GetPType() string {
+	if x != nil {
+		return x.PType
+	}
+	return ""
+}
+
+func (x *FilteredPolicyRequest) GetFieldIndex() int32 {
+	if x != nil {
+		return x.FieldIndex
+	}
+	return 0
+}
+
+func (x *FilteredPolicyRequest) GetFieldValues() []string {
+	if x != nil {
+		return x.FieldValues
+	}
+	return nil
+}
+
+type UserRoleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EnforcerHandler int32    `protobuf:"varint,1,opt,name=enforcerHandler,proto3" json:"enforcerHandler,omitempty"`
+	User            string   `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	Role            string   `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	Domain          []string `protobuf:"bytes,4,rep,name=domain,proto3" json:"domain,omitempty"`
+}
+
+func (x *UserRoleRequest) Reset() {
+	*x = UserRoleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_casbin_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserRoleRequest) ProtoMessage() {}
+
+func (x *UserRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_casbin_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserRoleRequest.ProtoReflect.Descriptor instead.
+func (*UserRoleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_casbin_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UserRoleRequest) GetEnforcerHandler() int32 {
+	if x != nil {
+		return x.EnforcerHandler
+	}
+	return 0
+}
+
+func (x *UserRoleRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *UserRoleRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *UserRoleRequest) GetDomain() []string {
+	if x != nil {
+		return x.Domain
+	}
+	return nil
+}
+
+type PermissionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EnforcerHandler int32    `protobuf:"varint,1,opt,name=enforcerHandler,proto3" json:"enforcerHandler,omitempty"`
+	User            string   `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	Permissions     []string `protobuf:"bytes,3,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	Domain          []string `protobuf:"bytes,4,rep,name=domain,proto3" json:"domain,omitempty"`
+}
+
+func (x *PermissionRequest) Reset() {
+	*x = PermissionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_casbin_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PermissionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PermissionRequest) ProtoMessage() {}
+
+func (x *PermissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_casbin_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PermissionRequest.ProtoReflect.Descriptor instead.
+func (*PermissionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_casbin_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *PermissionRequest) GetEnforcerHandler() int32 {
+	if x != nil {
+		return x.EnforcerHandler
+	}
+	return 0
+}
+
+func (x *PermissionRequest) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *PermissionRequest) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *PermissionRequest) GetDomain() []string {
+	if x != nil {
+		return x.Domain
+	}
+	return nil
+}
+
+type Array2DReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	D2 []*Array2DReplyD `protobuf:"bytes,1,rep,name=d2,proto3" json:"d2,omitempty"`
+}
+
+func (x *Array2DReply) Reset() {
+	*x = Array2DReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_casbin_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Array2DReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Array2DReply) ProtoMessage() {}
+
+func (x *Array2DReply) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_casbin_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Array2DReply.ProtoReflect.Descriptor instead.
+func (*Array2DReply) Descriptor() ([]byte, []int) {
+	return file_proto_casbin_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *Array2DReply) GetD2() []*Array2DReplyD {
+	if x != nil {
+		return x.D2
+	}
+	return nil
+}
+
+type EnforcerListReplyEnforcer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Handler int32  `protobuf:"varint,1,opt,name=handler,proto3" json:"handler,omitempty"`
+	Name    string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *EnforcerListReplyEnforcer) Reset() {
+	*x = EnforcerListReplyEnforcer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_casbin_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnforcerListReplyEnforcer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnforcerListReplyEnforcer) ProtoMessage() {}
+
+func (x *EnforcerListReplyEnforcer) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_casbin_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnforcerListReplyEnforcer.ProtoReflect.Descriptor instead.
+func (*EnforcerListReplyEnforcer) Descriptor() ([]byte, []int) {
+	return file_proto_casbin_proto_rawDescGZIP(), []int{3, 0}
+}
+
+func (x *EnforcerListReplyEnforcer) GetHandler() int32 {
+	if x != nil {
+		return x.Handler
+	}
+	return 0
+}
+
+func (x *EnforcerListReplyEnforcer) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type BatchEnforceRequestRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Params []string          `protobuf:"bytes,1,rep,name=params,proto3" json:"params,omitempty"`
+	Values []*structpb.Value `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+}
+
+func (x *BatchEnforceRequestRequest) Reset() {
+	*x = BatchEnforceRequestRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_casbin_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchEnforceRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchEnforceRequestRequest) ProtoMessage() {}
+
+func (x *BatchEnforceRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_casbin_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchEnforceRequestRequest.ProtoReflect.Descriptor instead.
+func (*BatchEnforceRequestRequest) Descriptor() ([]byte, []int) {
+	return file_proto_casbin_proto_rawDescGZIP(), []int{7, 0}
+}
+
+func (x *BatchEnforceRequestRequest) GetParams() []string {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *BatchEnforceRequestRequest) GetValues() []*structpb.Value {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type PoliciesRequestRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Params []string `protobuf:"bytes,1,rep,name=params,proto3" json:"params,omitempty"`
+}
+
+func (x *PoliciesRequestRule) Reset() {
+	*x = PoliciesRequestRule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_casbin_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PoliciesRequestRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PoliciesRequestRule) ProtoMessage() {}
+
+func (x *PoliciesRequestRule) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_casbin_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PoliciesRequestRule.ProtoReflect.Descriptor instead.
+func (*PoliciesRequestRule) Descriptor() ([]byte, []int) {
+	return file_proto_casbin_proto_rawDescGZIP(), []int{14, 0}
+}
+
+func (x *PoliciesRequestRule) GetParams() []string {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+type Array2DReplyD struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	D1 []string `protobuf:"bytes,1,rep,name=d1,proto3" json:"d1,omitempty"`
+}
+
+func (x *Array2DReplyD) Reset() {
+	*x = Array2DReplyD{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_casbin_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Array2DReplyD) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Array2DReplyD) ProtoMessage() {}
+
+func (x *Array2DReplyD) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_casbin_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Array2DReplyD.ProtoReflect.Descriptor instead.
+func (*Array2DReplyD) Descriptor() ([]byte, []int) {
+	return file_proto_casbin_proto_rawDescGZIP(), []int{24, 0}
+}
+
+func (x *Array2DReplyD) GetD1() []string {
+	if x != nil {
+		return x.D1
+	}
+	return nil
+}
+
+var File_proto_casbin_proto protoreflect.FileDescriptor
+
+var file_proto_casbin_proto_rawDesc = []byte{
+	0x0a, 0x12, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x61, 0x73, 0x62, 0x69, 0x6e, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73, 0x74, 0x72,
+	0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xcc, 0x02, 0x0a, 0x12, 0x4e, 0x65,
+	0x77, 0x45, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x54, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x54, 0x65, 0x78, 0x74, 0x12, 0x24,
+	0x0a, 0x0d, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x61, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x48, 0x61,
+	0x6e, 0x64, 0x6c, 0x65, 0x12, 0x38, 0x0a, 0x17, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x63,
+	0x63, 0x65, 0x70, 0x74, 0x4a, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x17, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x63, 0x63,
+	0x65, 0x70, 0x74, 0x4a, 0x73, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x67, 0x65, 0x74, 0x4f, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x67, 0x65, 0x74, 0x4f, 0x72, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x77, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x44,
+	0x72, 0x69, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x11, 0x77, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x32, 0x0a, 0x14, 0x77, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x43, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x14, 0x77, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x43, 0x61, 0x63, 0x68, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x65, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x43, 0x61, 0x63, 0x68, 0x65, 0x22, 0x2c, 0x0a, 0x10, 0x4e, 0x65, 0x77, 0x45,
+	0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07,
+	0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x68,
+	0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x22, 0x29, 0x0a, 0x13, 0x45, 0x6e, 0x66, 0x6f, 0x72, 0x63,
+	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x22, 0x8e, 0x01, 0x0a, 0x11, 0x45, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x72, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3f, 0x0a, 0x09, 0x65, 0x6e, 0x66, 0x6f, 0x72,
+	0x63, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x45, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x2e, 0x65, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x72, 0x52, 0x09, 0x65,
+	0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x72, 0x73, 0x1a, 0x38, 0x0a, 0x08, 0x65, 0x6e, 0x66, 0x6f,
+	0x72, 0x63, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x22, 0x9d, 0x01, 0x0a, 0x11, 0x4e, 0x65, 0x77, 0x41, 0x64, 0x61, 0x70, 0x74, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x64, 0x61, 0x70,
+	0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61,
+	0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x72,
+	0x69, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x64, 0x62, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69,
+	0x65, 0x64, 0x22, 0x2b, 0x0a, 0x0f, 0x4e, 0x65, 0x77, 0x41, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x22,
+	0x82, 0x01, 0x0a, 0x0e, 0x45, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x65, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x72, 0x48, 0x61,
+	0x6e, 0x64, 0x6c, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x65, 0x6e, 0x66,
+	0x6f, 0x72, 0x63, 0x65, 0x72, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06,
+	0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x70, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x12, 0x2e, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x22, 0xd2, 0x01, 0x0a, 0x13, 0x42, 0x61, 0x74, 0x63, 0x68, 0x45, 0x6e,
+	0x66, 0x6f, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0f,
+	0x65, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x72, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x65, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x72, 0x48,
+	0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x12, 0x3e, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x45, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x2e, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x08, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x1a, 0x51, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x2e, 0x0a, 0x06, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x1d, 0x0a, 0x09, 0x42, 0x6f, 0x6f,
+	0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x03, 0x72, 0x65, 0x73, 0x22, 0x22, 0x0a, 0x0e, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x08, 0x52, 0x03, 0x72, 0x65, 0x73, 0x22, 0x3c, 0x0a, 0x0e,
+	0x45, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x45, 0x78, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x72, 0x65, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x07, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x22, 0x28, 0x0a, 0x0c, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x61,
+	0x6e, 0x64, 0x6c, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x68, 0x61, 0x6e,
+	0x64, 0x6c, 0x65, 0x72, 0x22, 0x0c, 0x0a, 0x0a, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x67, 0x0a, 0x0d, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x65, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x72, 0x48,
+	0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x65, 0x6e,
+	0x66, 0x6f, 0x72, 0x63, 0x65, 0x72, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x12, 0x14, 0x0a,
+	0x05, 0x70, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0xa4, 0x01, 0x0a, 0x0f,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x28, 0x0a, 0x0f, 0x65, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x72, 0x48, 0x61, 0x6e, 0x64, 0x6c,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x65, 0x6e, 0x66, 0x6f, 0x72, 0x63,
+	0x65, 0x72, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x54, 0x79,
+	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x31, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x72, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x75, 0x6c,
+	0x65, 0x73, 0x1a, 0x1e, 0x0a, 0x04, 0x72, 0x75, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x22, 0x89, 0x01, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x65, 0x6e,
+	0x66, 0x6f, 0x72, 0x63, 0x65, 0x72, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0f, 0x65, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x72, 0x48, 0x61, 0x6e,
+	0x64, 0x6c, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x6c,
+	0x64, 0x52, 0x75, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x6c, 0x64,
+	0x52, 0x75, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x77, 0x52, 0x75, 0x6c, 0x65, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x77, 0x52, 0x75, 0x6c, 0x65, 0x22, 0xc9,
+	0x01, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x65, 0x6e, 0x66, 0x6f,
+	0x72, 0x63, 0x65, 0x72, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0f, 0x65, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x72, 0x48, 0x61, 0x6e, 0x64, 0x6c,
+	0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x70, 0x54, 0x79, 0x70, 0x65, 0x12, 0x37, 0x0a, 0x08, 0x6f, 0x6c, 0x64, 0x52,
+	0x75, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x2e, 0x72, 0x75, 0x6c, 0x65, 0x52, 0x08, 0x6f, 0x6c, 0x64, 0x52, 0x75, 0x6c, 0x65,
+	0x73, 0x12, 0x37, 0x0a, 0x08, 0x6e, 0x65, 0x77, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x72, 0x75, 0x6c, 0x65,
+	0x52, 0x08, 0x6e, 0x65, 0x77, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x22, 0xda, 0x01, 0x0a, 0x1d, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0f,
+	0x65, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x72, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x65, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x72, 0x48,
+	0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x54, 0x79, 0x70, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x54, 0x79, 0x70, 0x65, 0x12, 0x37, 0x0a, 0x08,
+	0x6e, 0x65, 0x77, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x72, 0x75, 0x6c, 0x65, 0x52, 0x08, 0x6e, 0x65, 0x77,
+	0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x69, 0x65, 0x6c,
+	0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x95, 0x02, 0x0a, 0x0b, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x35, 0x0a, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x52, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x73, 0x65, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x73, 0x65, 0x63,
+	0x12, 0x14, 0x0a, 0x05, 0x70, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x70, 0x54, 0x79, 0x70, 0x65, 0x12, 0x31, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x72, 0x75,
+	0x6c, 0x65, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x37, 0x0a, 0x08, 0x6f, 0x6c, 0x64,
+	0x52, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x2e, 0x72, 0x75, 0x6c, 0x65, 0x52, 0x08, 0x6f, 0x6c, 0x64, 0x52, 0x75, 0x6c,
+	0x65, 0x73, 0x22, 0x3b, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x44,
+	0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x10, 0x01, 0x12,
+	0x0a, 0x0a, 0x06, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x4c,
+	0x4f, 0x41, 0x44, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x41, 0x56, 0x45, 0x10, 0x04, 0x22,
 	0x52, 0x0a, 0x10, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
 	0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x65, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x72, 0x48,
 	0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x65, 0x6e,
@@ -1006,7 +1999,7 @@ var file_proto_casbin_proto_rawDesc = []byte{
 	0x0a, 0x02, 0x64, 0x32, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x2e, 0x41, 0x72, 0x72, 0x61, 0x79, 0x32, 0x44, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e,
 	0x64, 0x52, 0x02, 0x64, 0x32, 0x1a, 0x13, 0x0a, 0x01, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x64, 0x31,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x02, 0x64, 0x31, 0x32, 0x95, 0x1c, 0x0a, 0x06, 0x43,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x02, 0x64, 0x31, 0x32, 0xdd, 0x29, 0x0a, 0x06, 0x43,
 	0x61, 0x73, 0x62, 0x69, 0x6e, 0x12, 0x43, 0x0a, 0x0b, 0x4e, 0x65, 0x77, 0x45, 0x6e, 0x66, 0x6f,
 	0x72, 0x63, 0x65, 0x72, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x65, 0x77,
 	0x45, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
@@ -1015,228 +2008,337 @@ var file_proto_casbin_proto_rawDesc = []byte{
 	0x77, 0x41, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x2e, 0x4e, 0x65, 0x77, 0x41, 0x64, 0x61, 0x70, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
 	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x65, 0x77, 0x41, 0x64,
-	0x61, 0x70, 0x74, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x07,
-	0x45, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x45, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x22, 0x00, 0x12, 0x36, 0x0a, 0x0a, 0x4c, 0x6f, 0x61, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
-	0x12, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x0a, 0x53, 0x61,
-	0x76, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x22, 0x00, 0x12, 0x35, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12,
-	0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6f,
-	0x6f, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0e, 0x41, 0x64, 0x64,
-	0x4e, 0x61, 0x6d, 0x65, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x14, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x0c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50,
-	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6f,
-	0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12,
-	0x3d, 0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x64, 0x50, 0x6f,
+	0x61, 0x70, 0x74, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x0c,
+	0x46, 0x72, 0x65, 0x65, 0x45, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x72, 0x12, 0x13, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0b, 0x46, 0x72, 0x65, 0x65, 0x41, 0x64,
+	0x61, 0x70, 0x74, 0x65, 0x72, 0x12, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12,
+	0x4a, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x72, 0x42, 0x79,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6e, 0x66,
+	0x6f, 0x72, 0x63, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x65, 0x77, 0x45, 0x6e, 0x66, 0x6f,
+	0x72, 0x63, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0d, 0x4c,
+	0x69, 0x73, 0x74, 0x45, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x72, 0x73, 0x12, 0x13, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6e, 0x66, 0x6f, 0x72, 0x63,
+	0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x34, 0x0a,
+	0x07, 0x45, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x45, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x45, 0x6e, 0x66, 0x6f,
+	0x72, 0x63, 0x65, 0x12, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x45, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x42, 0x6f, 0x6f,
+	0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x09, 0x45, 0x6e, 0x66, 0x6f,
+	0x72, 0x63, 0x65, 0x45, 0x78, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6e,
+	0x66, 0x6f, 0x72, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x45, 0x78, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x0a, 0x4c, 0x6f, 0x61, 0x64, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x12, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x36, 0x0a,
+	0x0a, 0x53, 0x61, 0x76, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x13, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0b, 0x57, 0x61, 0x74, 0x63, 0x68, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x12, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x00, 0x30,
+	0x01, 0x12, 0x35, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x14,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x6f,
+	0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x4e,
+	0x61, 0x6d, 0x65, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x0c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x6f,
 	0x6c, 0x69, 0x63, 0x79, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6f, 0x6c,
 	0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x48,
-	0x0a, 0x14, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64,
-	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x6f,
-	0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x19, 0x52, 0x65, 0x6d, 0x6f,
-	0x76, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x64, 0x50,
+	0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x3d,
+	0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x64, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x48, 0x0a,
+	0x14, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x50,
 	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x69,
 	0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75,
 	0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x6f, 0x6c,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x50, 0x6f,
-	0x6c, 0x69, 0x63, 0x79, 0x12, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x41, 0x72, 0x72, 0x61, 0x79, 0x32, 0x44, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00,
-	0x12, 0x3d, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x64, 0x50, 0x6f, 0x6c, 0x69,
-	0x63, 0x79, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63,
-	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x41, 0x72, 0x72, 0x61, 0x79, 0x32, 0x44, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12,
-	0x48, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x50, 0x6f,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x19, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x64, 0x50, 0x6f,
 	0x6c, 0x69, 0x63, 0x79, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x69, 0x6c,
 	0x74, 0x65, 0x72, 0x65, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x72, 0x72, 0x61, 0x79,
-	0x32, 0x44, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x16, 0x47, 0x65, 0x74,
-	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x64, 0x50, 0x6f, 0x6c,
+	0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x12, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x41, 0x72, 0x72, 0x61, 0x79, 0x32, 0x44, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12,
+	0x3d, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x41, 0x72, 0x72, 0x61, 0x79, 0x32, 0x44, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x48,
+	0x0a, 0x11, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x50, 0x6f, 0x6c,
 	0x69, 0x63, 0x79, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x69, 0x6c, 0x74,
 	0x65, 0x72, 0x65, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x1a, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x72, 0x72, 0x61, 0x79, 0x32,
-	0x44, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x14, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x44, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x64, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x65, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x72, 0x72, 0x61, 0x79, 0x32, 0x44,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x14, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x16, 0x41, 0x64, 0x64, 0x4e, 0x61, 0x6d,
+	0x65, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42,
+	0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x14, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x19,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x1c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x65, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x65, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x21, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x64, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x0b,
+	0x41, 0x64, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
 	0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x6f, 0x6c,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x16, 0x41, 0x64, 0x64, 0x4e, 0x61,
-	0x6d, 0x65, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63,
-	0x79, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x4e, 0x61,
+	0x6d, 0x65, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x6f, 0x6c,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x0e, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x13, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x6f,
+	0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x41, 0x0a, 0x13, 0x41, 0x64, 0x64, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x12,
+	0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x14, 0x52,
-	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c,
-	0x69, 0x63, 0x79, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6f, 0x6c, 0x69,
-	0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x45, 0x0a,
-	0x19, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x64, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x18, 0x41,
+	0x64, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x69, 0x6e, 0x67, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x16, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x12, 0x16, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6f,
+	0x6f, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x1b, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x69, 0x6e, 0x67,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x1c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x46, 0x69,
-	0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x69, 0x6e, 0x67, 0x50, 0x6f,
-	0x6c, 0x69, 0x63, 0x79, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x65, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x6c, 0x79, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x12, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6f,
+	0x6f, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x12, 0x1c, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x47, 0x0a,
+	0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x64, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x69, 0x65, 0x73, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
 	0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x21, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
-	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x64, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1c, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x50, 0x6f, 0x6c, 0x69,
-	0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x3f, 0x0a,
-	0x11, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69,
-	0x63, 0x79, 0x12, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x41, 0x72, 0x72, 0x61, 0x79, 0x32, 0x44, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x45,
-	0x0a, 0x16, 0x47, 0x65, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x69,
-	0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x72, 0x72, 0x61, 0x79, 0x32, 0x44, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x65, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69,
-	0x63, 0x79, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65,
-	0x72, 0x65, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x72, 0x72, 0x61, 0x79, 0x32, 0x44,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x46, 0x69,
-	0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x41, 0x72, 0x72, 0x61, 0x79, 0x32, 0x44, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x3a,
-	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73,
-	0x12, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x72,
-	0x72, 0x61, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x13, 0x47, 0x65,
-	0x74, 0x41, 0x6c, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x64, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74,
-	0x73, 0x12, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65,
-	0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x41, 0x72, 0x72, 0x61, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12,
-	0x39, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73,
-	0x12, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x72,
-	0x72, 0x61, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x12, 0x47, 0x65,
-	0x74, 0x41, 0x6c, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73,
-	0x12, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x47,
-	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x41, 0x72, 0x72, 0x61, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x39,
-	0x0a, 0x0d, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
-	0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x72, 0x72,
-	0x61, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x12, 0x47, 0x65, 0x74,
-	0x41, 0x6c, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
-	0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x47, 0x65,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x41, 0x72, 0x72, 0x61, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x37, 0x0a,
-	0x0b, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x13, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x72, 0x72, 0x61, 0x79, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c,
-	0x4e, 0x61, 0x6d, 0x65, 0x64, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x17, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x72, 0x72, 0x61,
-	0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x09, 0x48, 0x61, 0x73, 0x50,
-	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6f,
-	0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12,
-	0x3a, 0x0a, 0x0e, 0x48, 0x61, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63,
-	0x79, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73,
+	0x12, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42,
+	0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x1b, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x4e, 0x61, 0x6d, 0x65,
+	0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x12, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1a, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x11, 0x48,
-	0x61, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x4b, 0x0a, 0x19, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x69,
+	0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x6f,
+	0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69,
+	0x65, 0x73, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x69, 0x65, 0x73, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x13, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x72, 0x72, 0x61, 0x79, 0x32, 0x44, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
 	0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42,
-	0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x16, 0x48, 0x61,
-	0x73, 0x4e, 0x61, 0x6d, 0x65, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x69, 0x6e, 0x67, 0x50, 0x6f,
-	0x6c, 0x69, 0x63, 0x79, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6f, 0x6c,
-	0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x39,
-	0x0a, 0x0a, 0x47, 0x65, 0x74, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x16, 0x2e, 0x70,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41,
+	0x72, 0x72, 0x61, 0x79, 0x32, 0x44, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x50, 0x0a,
+	0x19, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x41, 0x72, 0x72, 0x61, 0x79, 0x32, 0x44, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12,
+	0x55, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x4e, 0x61,
+	0x6d, 0x65, 0x64, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x65, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x72, 0x72, 0x61, 0x79, 0x32, 0x44, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c,
+	0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x72, 0x72, 0x61, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x00, 0x12, 0x43, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4e, 0x61, 0x6d, 0x65,
+	0x64, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x72, 0x72, 0x61, 0x79,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x41, 0x6c,
+	0x6c, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x72, 0x72, 0x61, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x00, 0x12, 0x42, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4e, 0x61, 0x6d, 0x65,
+	0x64, 0x4f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x72, 0x72, 0x61, 0x79, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x72, 0x72, 0x61, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x00, 0x12, 0x42, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x64,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x53, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x72, 0x72, 0x61, 0x79, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x37, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x52,
+	0x6f, 0x6c, 0x65, 0x73, 0x12, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x41, 0x72, 0x72, 0x61, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x40,
+	0x0a, 0x10, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x64, 0x52, 0x6f, 0x6c,
+	0x65, 0x73, 0x12, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x69, 0x6d, 0x70, 0x6c,
+	0x65, 0x47, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x72, 0x72, 0x61, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00,
+	0x12, 0x35, 0x0a, 0x09, 0x48, 0x61, 0x73, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x14, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x6f, 0x6c,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x0e, 0x48, 0x61, 0x73, 0x4e, 0x61,
+	0x6d, 0x65, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x11, 0x48, 0x61, 0x73, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x69,
+	0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x00, 0x12, 0x42, 0x0a, 0x16, 0x48, 0x61, 0x73, 0x4e, 0x61, 0x6d, 0x65, 0x64, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x14, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x44, 0x6f, 0x6d,
+	0x61, 0x69, 0x6e, 0x73, 0x12, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x72, 0x72, 0x61, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x00, 0x12, 0x3e, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x46, 0x6f, 0x72,
+	0x55, 0x73, 0x65, 0x72, 0x12, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x72, 0x72, 0x61, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x00, 0x12, 0x46, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x49, 0x6d, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74,
+	0x52, 0x6f, 0x6c, 0x65, 0x73, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x12, 0x16, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x72, 0x72,
 	0x61, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x3e, 0x0a, 0x0f, 0x47, 0x65, 0x74,
-	0x52, 0x6f, 0x6c, 0x65, 0x73, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x12, 0x16, 0x2e, 0x70,
+	0x55, 0x73, 0x65, 0x72, 0x73, 0x46, 0x6f, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x16, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x72, 0x72,
-	0x61, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x17, 0x47, 0x65, 0x74,
-	0x49, 0x6d, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x46, 0x6f, 0x72,
-	0x55, 0x73, 0x65, 0x72, 0x12, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65,
-	0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x72, 0x72, 0x61, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
-	0x00, 0x12, 0x3e, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x46, 0x6f, 0x72,
-	0x52, 0x6f, 0x6c, 0x65, 0x12, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65,
-	0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x72, 0x72, 0x61, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
-	0x00, 0x12, 0x3c, 0x0a, 0x0e, 0x48, 0x61, 0x73, 0x52, 0x6f, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x55,
-	0x73, 0x65, 0x72, 0x12, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72,
-	0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12,
-	0x3c, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x52, 0x6f, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65,
-	0x72, 0x12, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f,
-	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x3f, 0x0a,
-	0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x55, 0x73,
-	0x65, 0x72, 0x12, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52,
-	0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x40,
-	0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x46, 0x6f, 0x72,
-	0x55, 0x73, 0x65, 0x72, 0x12, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65,
-	0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00,
-	0x12, 0x38, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x16,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42,
-	0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x0a, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x12, 0x18,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x41, 0x72, 0x72, 0x61, 0x79, 0x32, 0x44, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12,
-	0x50, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x49, 0x6d, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x50, 0x65,
-	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72,
-	0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x41, 0x72, 0x72, 0x61, 0x79, 0x32, 0x44, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
-	0x00, 0x12, 0x40, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x65,
-	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x14, 0x41, 0x64, 0x64, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x12, 0x18, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6f,
-	0x6f, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x17, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x72,
-	0x55, 0x73, 0x65, 0x72, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x65, 0x72,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x22, 0x00, 0x12, 0x48, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x65, 0x72, 0x6d,
-	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x12, 0x18,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x14,
-	0x48, 0x61, 0x73, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x72,
-	0x55, 0x73, 0x65, 0x72, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x65, 0x72,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10,
+	0x61, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x0e, 0x48, 0x61, 0x73,
+	0x52, 0x6f, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x12, 0x16, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x6f, 0x6c,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x0e, 0x41, 0x64, 0x64, 0x52, 0x6f,
+	0x6c, 0x65, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x12, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
+	0x6f, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x12, 0x16, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x52, 0x6f, 0x6c, 0x65, 0x73, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x12, 0x16, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x6f,
+	0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x22, 0x00, 0x42, 0x42, 0x0a, 0x16, 0x69, 0x6f, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x65, 0x78,
-	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x0b, 0x43, 0x61,
-	0x73, 0x62, 0x69, 0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x08, 0x2e, 0x2f, 0x3b,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0xaa, 0x02, 0x0e, 0x43, 0x61, 0x73, 0x62, 0x69, 0x6e, 0x4f, 0x72,
-	0x67, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x22, 0x00, 0x12, 0x39, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65,
+	0x12, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x48, 0x0a,
+	0x15, 0x47, 0x65, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x46,
+	0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50,
+	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x72, 0x72, 0x61, 0x79, 0x32, 0x44,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x49, 0x6d,
+	0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x72, 0x72, 0x61, 0x79,
+	0x32, 0x44, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x10, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x14, 0x41,
+	0x64, 0x64, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x72, 0x55,
+	0x73, 0x65, 0x72, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x65, 0x72, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x00, 0x12, 0x47, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x12, 0x18, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42,
+	0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x18, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x46,
+	0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50,
+	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x14, 0x48, 0x61, 0x73, 0x50, 0x65, 0x72, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x72, 0x55, 0x73, 0x65, 0x72, 0x12, 0x18, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42,
+	0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x42, 0x42, 0x0a, 0x16, 0x69, 0x6f,
+	0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x42, 0x0b, 0x43, 0x61, 0x73, 0x62, 0x69, 0x6e, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x50, 0x01, 0x5a, 0x08, 0x2e, 0x2f, 0x3b, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0xaa, 0x02, 0x0e,
+	0x43, 0x61, 0x73, 0x62, 0x69, 0x6e, 0x4f, 0x72, 0x67, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1251,140 +2353,217 @@ func file_proto_casbin_proto_rawDescGZIP() []byte {
 	return file_proto_casbin_proto_rawDescData
 }
 
-var file_proto_casbin_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_proto_casbin_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_proto_casbin_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
 var file_proto_casbin_proto_goTypes = []interface{}{
-	(*NewEnforcerRequest)(nil),    // 0: proto.NewEnforcerRequest
-	(*NewEnforcerReply)(nil),      // 1: proto.NewEnforcerReply
-	(*NewAdapterRequest)(nil),     // 2: proto.NewAdapterRequest
-	(*NewAdapterReply)(nil),       // 3: proto.NewAdapterReply
-	(*EnforceRequest)(nil),        // 4: proto.EnforceRequest
-	(*BoolReply)(nil),             // 5: proto.BoolReply
-	(*EmptyRequest)(nil),          // 6: proto.EmptyRequest
-	(*EmptyReply)(nil),            // 7: proto.EmptyReply
-	(*PolicyRequest)(nil),         // 8: proto.PolicyRequest
-	(*SimpleGetRequest)(nil),      // 9: proto.SimpleGetRequest
-	(*ArrayReply)(nil),            // 10: proto.ArrayReply
-	(*FilteredPolicyRequest)(nil), // 11: proto.FilteredPolicyRequest
-	(*UserRoleRequest)(nil),       // 12: proto.UserRoleRequest
-	(*PermissionRequest)(nil),     // 13: proto.PermissionRequest
-	(*Array2DReply)(nil),          // 14: proto.Array2DReply
-	(*Array2DReplyD)(nil),         // 15: proto.Array2DReply.d
+	(PolicyEventType)(0),                  // 0: proto.PolicyEvent.type
+	(*NewEnforcerRequest)(nil),            // 1: proto.NewEnforcerRequest
+	(*NewEnforcerReply)(nil),              // 2: proto.NewEnforcerReply
+	(*EnforcerNameRequest)(nil),           // 3: proto.EnforcerNameRequest
+	(*EnforcerListReply)(nil),             // 4: proto.EnforcerListReply
+	(*NewAdapterRequest)(nil),             // 5: proto.NewAdapterRequest
+	(*NewAdapterReply)(nil),               // 6: proto.NewAdapterReply
+	(*EnforceRequest)(nil),                // 7: proto.EnforceRequest
+	(*BatchEnforceRequest)(nil),           // 8: proto.BatchEnforceRequest
+	(*BoolReply)(nil),                     // 9: proto.BoolReply
+	(*BatchBoolReply)(nil),                // 10: proto.BatchBoolReply
+	(*EnforceExReply)(nil),                // 11: proto.EnforceExReply
+	(*EmptyRequest)(nil),                  // 12: proto.EmptyRequest
+	(*EmptyReply)(nil),                    // 13: proto.EmptyReply
+	(*PolicyRequest)(nil),                 // 14: proto.PolicyRequest
+	(*PoliciesRequest)(nil),               // 15: proto.PoliciesRequest
+	(*UpdatePolicyRequest)(nil),           // 16: proto.UpdatePolicyRequest
+	(*UpdatePoliciesRequest)(nil),         // 17: proto.UpdatePoliciesRequest
+	(*UpdateFilteredPoliciesRequest)(nil), // 18: proto.UpdateFilteredPoliciesRequest
+	(*PolicyEvent)(nil),                   // 19: proto.PolicyEvent
+	(*SimpleGetRequest)(nil),              // 20: proto.SimpleGetRequest
+	(*ArrayReply)(nil),                    // 21: proto.ArrayReply
+	(*FilteredPolicyRequest)(nil),         // 22: proto.FilteredPolicyRequest
+	(*UserRoleRequest)(nil),               // 23: proto.UserRoleRequest
+	(*PermissionRequest)(nil),             // 24: proto.PermissionRequest
+	(*Array2DReply)(nil),                  // 25: proto.Array2DReply
+	(*EnforcerListReplyEnforcer)(nil),     // 26: proto.EnforcerListReply.enforcer
+	(*BatchEnforceRequestRequest)(nil),    // 27: proto.BatchEnforceRequest.request
+	(*PoliciesRequestRule)(nil),           // 28: proto.PoliciesRequest.rule
+	(*Array2DReplyD)(nil),                 // 29: proto.Array2DReply.d
+	(*structpb.Value)(nil),                // 30: google.protobuf.Value
 }
 var file_proto_casbin_proto_depIdxs = []int32{
-	15, // 0: proto.Array2DReply.d2:type_name -> proto.Array2DReply.d
-	0,  // 1: proto.Casbin.NewEnforcer:input_type -> proto.NewEnforcerRequest
-	2,  // 2: proto.Casbin.NewAdapter:input_type -> proto.NewAdapterRequest
-	4,  // 3: proto.Casbin.Enforce:input_type -> proto.EnforceRequest
-	6,  // 4: proto.Casbin.LoadPolicy:input_type -> proto.EmptyRequest
-	6,  // 5: proto.Casbin.SavePolicy:input_type -> proto.EmptyRequest
-	8,  // 6: proto.Casbin.AddPolicy:input_type -> proto.PolicyRequest
-	8,  // 7: proto.Casbin.AddNamedPolicy:input_type -> proto.PolicyRequest
-	8,  // 8: proto.Casbin.RemovePolicy:input_type -> proto.PolicyRequest
-	8,  // 9: proto.Casbin.RemoveNamedPolicy:input_type -> proto.PolicyRequest
-	11, // 10: proto.Casbin.RemoveFilteredPolicy:input_type -> proto.FilteredPolicyRequest
-	11, // 11: proto.Casbin.RemoveFilteredNamedPolicy:input_type -> proto.FilteredPolicyRequest
-	6,  // 12: proto.Casbin.GetPolicy:input_type -> proto.EmptyRequest
-	8,  // 13: proto.Casbin.GetNamedPolicy:input_type -> proto.PolicyRequest
-	11, // 14: proto.Casbin.GetFilteredPolicy:input_type -> proto.FilteredPolicyRequest
-	11, // 15: proto.Casbin.GetFilteredNamedPolicy:input_type -> proto.FilteredPolicyRequest
-	8,  // 16: proto.Casbin.AddGroupingPolicy:input_type -> proto.PolicyRequest
-	8,  // 17: proto.Casbin.AddNamedGroupingPolicy:input_type -> proto.PolicyRequest
-	8,  // 18: proto.Casbin.RemoveGroupingPolicy:input_type -> proto.PolicyRequest
-	8,  // 19: proto.Casbin.RemoveNamedGroupingPolicy:input_type -> proto.PolicyRequest
-	11, // 20: proto.Casbin.RemoveFilteredGroupingPolicy:input_type -> proto.FilteredPolicyRequest
-	11, // 21: proto.Casbin.RemoveFilteredNamedGroupingPolicy:input_type -> proto.FilteredPolicyRequest
-	6,  // 22: proto.Casbin.GetGroupingPolicy:input_type -> proto.EmptyRequest
-	8,  // 23: proto.Casbin.GetNamedGroupingPolicy:input_type -> proto.PolicyRequest
-	11, // 24: proto.Casbin.GetFilteredGroupingPolicy:input_type -> proto.FilteredPolicyRequest
-	11, // 25: proto.Casbin.GetFilteredNamedGroupingPolicy:input_type -> proto.FilteredPolicyRequest
-	6,  // 26: proto.Casbin.GetAllSubjects:input_type -> proto.EmptyRequest
-	9,  // 27: proto.Casbin.GetAllNamedSubjects:input_type -> proto.SimpleGetRequest
-	6,  // 28: proto.Casbin.GetAllObjects:input_type -> proto.EmptyRequest
-	9,  // 29: proto.Casbin.GetAllNamedObjects:input_type -> proto.SimpleGetRequest
-	6,  // 30: proto.Casbin.GetAllActions:input_type -> proto.EmptyRequest
-	9,  // 31: proto.Casbin.GetAllNamedActions:input_type -> proto.SimpleGetRequest
-	6,  // 32: proto.Casbin.GetAllRoles:input_type -> proto.EmptyRequest
-	9,  // 33: proto.Casbin.GetAllNamedRoles:input_type -> proto.SimpleGetRequest
-	8,  // 34: proto.Casbin.HasPolicy:input_type -> proto.PolicyRequest
-	8,  // 35: proto.Casbin.HasNamedPolicy:input_type -> proto.PolicyRequest
-	8,  // 36: proto.Casbin.HasGroupingPolicy:input_type -> proto.PolicyRequest
-	8,  // 37: proto.Casbin.HasNamedGroupingPolicy:input_type -> proto.PolicyRequest
-	12, // 38: proto.Casbin.GetDomains:input_type -> proto.UserRoleRequest
-	12, // 39: proto.Casbin.GetRolesForUser:input_type -> proto.UserRoleRequest
-	12, // 40: proto.Casbin.GetImplicitRolesForUser:input_type -> proto.UserRoleRequest
-	12, // 41: proto.Casbin.GetUsersForRole:input_type -> proto.UserRoleRequest
-	12, // 42: proto.Casbin.HasRoleForUser:input_type -> proto.UserRoleRequest
-	12, // 43: proto.Casbin.AddRoleForUser:input_type -> proto.UserRoleRequest
-	12, // 44: proto.Casbin.DeleteRoleForUser:input_type -> proto.UserRoleRequest
-	12, // 45: proto.Casbin.DeleteRolesForUser:input_type -> proto.UserRoleRequest
-	12, // 46: proto.Casbin.DeleteUser:input_type -> proto.UserRoleRequest
-	12, // 47: proto.Casbin.DeleteRole:input_type -> proto.UserRoleRequest
-	13, // 48: proto.Casbin.GetPermissionsForUser:input_type -> proto.PermissionRequest
-	13, // 49: proto.Casbin.GetImplicitPermissionsForUser:input_type -> proto.PermissionRequest
-	13, // 50: proto.Casbin.DeletePermission:input_type -> proto.PermissionRequest
-	13, // 51: proto.Casbin.AddPermissionForUser:input_type -> proto.PermissionRequest
-	13, // 52: proto.Casbin.DeletePermissionForUser:input_type -> proto.PermissionRequest
-	13, // 53: proto.Casbin.DeletePermissionsForUser:input_type -> proto.PermissionRequest
-	13, // 54: proto.Casbin.HasPermissionForUser:input_type -> proto.PermissionRequest
-	1,  // 55: proto.Casbin.NewEnforcer:output_type -> proto.NewEnforcerReply
-	3,  // 56: proto.Casbin.NewAdapter:output_type -> proto.NewAdapterReply
-	5,  // 57: proto.Casbin.Enforce:output_type -> proto.BoolReply
-	7,  // 58: proto.Casbin.LoadPolicy:output_type -> proto.EmptyReply
-	7,  // 59: proto.Casbin.SavePolicy:output_type -> proto.EmptyReply
-	5,  // 60: proto.Casbin.AddPolicy:output_type -> proto.BoolReply
-	5,  // 61: proto.Casbin.AddNamedPolicy:output_type -> proto.BoolReply
-	5,  // 62: proto.Casbin.RemovePolicy:output_type -> proto.BoolReply
-	5,  // 63: proto.Casbin.RemoveNamedPolicy:output_type -> proto.BoolReply
-	5,  // 64: proto.Casbin.RemoveFilteredPolicy:output_type -> proto.BoolReply
-	5,  // 65: proto.Casbin.RemoveFilteredNamedPolicy:output_type -> proto.BoolReply
-	14, // 66: proto.Casbin.GetPolicy:output_type -> proto.Array2DReply
-	14, // 67: proto.Casbin.GetNamedPolicy:output_type -> proto.Array2DReply
-	14, // 68: proto.Casbin.GetFilteredPolicy:output_type -> proto.Array2DReply
-	14, // 69: proto.Casbin.GetFilteredNamedPolicy:output_type -> proto.Array2DReply
-	5,  // 70: proto.Casbin.AddGroupingPolicy:output_type -> proto.BoolReply
-	5,  // 71: proto.Casbin.AddNamedGroupingPolicy:output_type -> proto.BoolReply
-	5,  // 72: proto.Casbin.RemoveGroupingPolicy:output_type -> proto.BoolReply
-	5,  // 73: proto.Casbin.RemoveNamedGroupingPolicy:output_type -> proto.BoolReply
-	5,  // 74: proto.Casbin.RemoveFilteredGroupingPolicy:output_type -> proto.BoolReply
-	5,  // 75: proto.Casbin.RemoveFilteredNamedGroupingPolicy:output_type -> proto.BoolReply
-	14, // 76: proto.Casbin.GetGroupingPolicy:output_type -> proto.Array2DReply
-	14, // 77: proto.Casbin.GetNamedGroupingPolicy:output_type -> proto.Array2DReply
-	14, // 78: proto.Casbin.GetFilteredGroupingPolicy:output_type -> proto.Array2DReply
-	14, // 79: proto.Casbin.GetFilteredNamedGroupingPolicy:output_type -> proto.Array2DReply
-	10, // 80: proto.Casbin.GetAllSubjects:output_type -> proto.ArrayReply
-	10, // 81: proto.Casbin.GetAllNamedSubjects:output_type -> proto.ArrayReply
-	10, // 82: proto.Casbin.GetAllObjects:output_type -> proto.ArrayReply
-	10, // 83: proto.Casbin.GetAllNamedObjects:output_type -> proto.ArrayReply
-	10, // 84: proto.Casbin.GetAllActions:output_type -> proto.ArrayReply
-	10, // 85: proto.Casbin.GetAllNamedActions:output_type -> proto.ArrayReply
-	10, // 86: proto.Casbin.GetAllRoles:output_type -> proto.ArrayReply
-	10, // 87: proto.Casbin.GetAllNamedRoles:output_type -> proto.ArrayReply
-	5,  // 88: proto.Casbin.HasPolicy:output_type -> proto.BoolReply
-	5,  // 89: proto.Casbin.HasNamedPolicy:output_type -> proto.BoolReply
-	5,  // 90: proto.Casbin.HasGroupingPolicy:output_type -> proto.BoolReply
-	5,  // 91: proto.Casbin.HasNamedGroupingPolicy:output_type -> proto.BoolReply
-	10, // 92: proto.Casbin.GetDomains:output_type -> proto.ArrayReply
-	10, // 93: proto.Casbin.GetRolesForUser:output_type -> proto.ArrayReply
-	10, // 94: proto.Casbin.GetImplicitRolesForUser:output_type -> proto.ArrayReply
-	10, // 95: proto.Casbin.GetUsersForRole:output_type -> proto.ArrayReply
-	5,  // 96: proto.Casbin.HasRoleForUser:output_type -> proto.BoolReply
-	5,  // 97: proto.Casbin.AddRoleForUser:output_type -> proto.BoolReply
-	5,  // 98: proto.Casbin.DeleteRoleForUser:output_type -> proto.BoolReply
-	5,  // 99: proto.Casbin.DeleteRolesForUser:output_type -> proto.BoolReply
-	5,  // 100: proto.Casbin.DeleteUser:output_type -> proto.BoolReply
-	7,  // 101: proto.Casbin.DeleteRole:output_type -> proto.EmptyReply
-	14, // 102: proto.Casbin.GetPermissionsForUser:output_type -> proto.Array2DReply
-	14, // 103: proto.Casbin.GetImplicitPermissionsForUser:output_type -> proto.Array2DReply
-	5,  // 104: proto.Casbin.DeletePermission:output_type -> proto.BoolReply
-	5,  // 105: proto.Casbin.AddPermissionForUser:output_type -> proto.BoolReply
-	5,  // 106: proto.Casbin.DeletePermissionForUser:output_type -> proto.BoolReply
-	5,  // 107: proto.Casbin.DeletePermissionsForUser:output_type -> proto.BoolReply
-	5,  // 108: proto.Casbin.HasPermissionForUser:output_type -> proto.BoolReply
-	55, // [55:109] is the sub-list for method output_type
-	1,  // [1:55] is the sub-list for method input_type
-	1,  // [1:1] is the sub-list for extension type_name
-	1,  // [1:1] is the sub-list for extension extendee
-	0,  // [0:1] is the sub-list for field type_name
+	26, // 0: proto.EnforcerListReply.enforcers:type_name -> proto.EnforcerListReply.enforcer
+	30, // 1: proto.EnforceRequest.values:type_name -> google.protobuf.Value
+	27, // 2: proto.BatchEnforceRequest.requests:type_name -> proto.BatchEnforceRequest.request
+	28, // 3: proto.PoliciesRequest.rules:type_name -> proto.PoliciesRequest.rule
+	28, // 4: proto.UpdatePoliciesRequest.oldRules:type_name -> proto.PoliciesRequest.rule
+	28, // 5: proto.UpdatePoliciesRequest.newRules:type_name -> proto.PoliciesRequest.rule
+	28, // 6: proto.UpdateFilteredPoliciesRequest.newRules:type_name -> proto.PoliciesRequest.rule
+	0,  // 7: proto.PolicyEvent.eventType:type_name -> proto.PolicyEvent.type
+	28, // 8: proto.PolicyEvent.rules:type_name -> proto.PoliciesRequest.rule
+	28, // 9: proto.PolicyEvent.oldRules:type_name -> proto.PoliciesRequest.rule
+	29, // 10: proto.Array2DReply.d2:type_name -> proto.Array2DReply.d
+	30, // 11: proto.BatchEnforceRequest.request.values:type_name -> google.protobuf.Value
+	1,  // 12: proto.Casbin.NewEnforcer:input_type -> proto.NewEnforcerRequest
+	5,  // 13: proto.Casbin.NewAdapter:input_type -> proto.NewAdapterRequest
+	12, // 14: proto.Casbin.FreeEnforcer:input_type -> proto.EmptyRequest
+	12, // 15: proto.Casbin.FreeAdapter:input_type -> proto.EmptyRequest
+	3,  // 16: proto.Casbin.GetEnforcerByName:input_type -> proto.EnforcerNameRequest
+	12, // 17: proto.Casbin.ListEnforcers:input_type -> proto.EmptyRequest
+	7,  // 18: proto.Casbin.Enforce:input_type -> proto.EnforceRequest
+	8,  // 19: proto.Casbin.BatchEnforce:input_type -> proto.BatchEnforceRequest
+	7,  // 20: proto.Casbin.EnforceEx:input_type -> proto.EnforceRequest
+	12, // 21: proto.Casbin.LoadPolicy:input_type -> proto.EmptyRequest
+	12, // 22: proto.Casbin.SavePolicy:input_type -> proto.EmptyRequest
+	12, // 23: proto.Casbin.WatchPolicy:input_type -> proto.EmptyRequest
+	14, // 24: proto.Casbin.AddPolicy:input_type -> proto.PolicyRequest
+	14, // 25: proto.Casbin.AddNamedPolicy:input_type -> proto.PolicyRequest
+	14, // 26: proto.Casbin.RemovePolicy:input_type -> proto.PolicyRequest
+	14, // 27: proto.Casbin.RemoveNamedPolicy:input_type -> proto.PolicyRequest
+	22, // 28: proto.Casbin.RemoveFilteredPolicy:input_type -> proto.FilteredPolicyRequest
+	22, // 29: proto.Casbin.RemoveFilteredNamedPolicy:input_type -> proto.FilteredPolicyRequest
+	12, // 30: proto.Casbin.GetPolicy:input_type -> proto.EmptyRequest
+	14, // 31: proto.Casbin.GetNamedPolicy:input_type -> proto.PolicyRequest
+	22, // 32: proto.Casbin.GetFilteredPolicy:input_type -> proto.FilteredPolicyRequest
+	22, // 33: proto.Casbin.GetFilteredNamedPolicy:input_type -> proto.FilteredPolicyRequest
+	14, // 34: proto.Casbin.AddGroupingPolicy:input_type -> proto.PolicyRequest
+	14, // 35: proto.Casbin.AddNamedGroupingPolicy:input_type -> proto.PolicyRequest
+	14, // 36: proto.Casbin.RemoveGroupingPolicy:input_type -> proto.PolicyRequest
+	14, // 37: proto.Casbin.RemoveNamedGroupingPolicy:input_type -> proto.PolicyRequest
+	22, // 38: proto.Casbin.RemoveFilteredGroupingPolicy:input_type -> proto.FilteredPolicyRequest
+	22, // 39: proto.Casbin.RemoveFilteredNamedGroupingPolicy:input_type -> proto.FilteredPolicyRequest
+	15, // 40: proto.Casbin.AddPolicies:input_type -> proto.PoliciesRequest
+	15, // 41: proto.Casbin.AddNamedPolicies:input_type -> proto.PoliciesRequest
+	15, // 42: proto.Casbin.RemovePolicies:input_type -> proto.PoliciesRequest
+	15, // 43: proto.Casbin.RemoveNamedPolicies:input_type -> proto.PoliciesRequest
+	15, // 44: proto.Casbin.AddGroupingPolicies:input_type -> proto.PoliciesRequest
+	15, // 45: proto.Casbin.AddNamedGroupingPolicies:input_type -> proto.PoliciesRequest
+	15, // 46: proto.Casbin.RemoveGroupingPolicies:input_type -> proto.PoliciesRequest
+	15, // 47: proto.Casbin.RemoveNamedGroupingPolicies:input_type -> proto.PoliciesRequest
+	16, // 48: proto.Casbin.UpdatePolicy:input_type -> proto.UpdatePolicyRequest
+	16, // 49: proto.Casbin.UpdateNamedPolicy:input_type -> proto.UpdatePolicyRequest
+	17, // 50: proto.Casbin.UpdatePolicies:input_type -> proto.UpdatePoliciesRequest
+	17, // 51: proto.Casbin.UpdateNamedPolicies:input_type -> proto.UpdatePoliciesRequest
+	18, // 52: proto.Casbin.UpdateFilteredPolicies:input_type -> proto.UpdateFilteredPoliciesRequest
+	18, // 53: proto.Casbin.UpdateFilteredNamedPolicies:input_type -> proto.UpdateFilteredPoliciesRequest
+	16, // 54: proto.Casbin.UpdateGroupingPolicy:input_type -> proto.UpdatePolicyRequest
+	16, // 55: proto.Casbin.UpdateNamedGroupingPolicy:input_type -> proto.UpdatePolicyRequest
+	17, // 56: proto.Casbin.UpdateGroupingPolicies:input_type -> proto.UpdatePoliciesRequest
+	17, // 57: proto.Casbin.UpdateNamedGroupingPolicies:input_type -> proto.UpdatePoliciesRequest
+	12, // 58: proto.Casbin.GetGroupingPolicy:input_type -> proto.EmptyRequest
+	14, // 59: proto.Casbin.GetNamedGroupingPolicy:input_type -> proto.PolicyRequest
+	22, // 60: proto.Casbin.GetFilteredGroupingPolicy:input_type -> proto.FilteredPolicyRequest
+	22, // 61: proto.Casbin.GetFilteredNamedGroupingPolicy:input_type -> proto.FilteredPolicyRequest
+	12, // 62: proto.Casbin.GetAllSubjects:input_type -> proto.EmptyRequest
+	20, // 63: proto.Casbin.GetAllNamedSubjects:input_type -> proto.SimpleGetRequest
+	12, // 64: proto.Casbin.GetAllObjects:input_type -> proto.EmptyRequest
+	20, // 65: proto.Casbin.GetAllNamedObjects:input_type -> proto.SimpleGetRequest
+	12, // 66: proto.Casbin.GetAllActions:input_type -> proto.EmptyRequest
+	20, // 67: proto.Casbin.GetAllNamedActions:input_type -> proto.SimpleGetRequest
+	12, // 68: proto.Casbin.GetAllRoles:input_type -> proto.EmptyRequest
+	20, // 69: proto.Casbin.GetAllNamedRoles:input_type -> proto.SimpleGetRequest
+	14, // 70: proto.Casbin.HasPolicy:input_type -> proto.PolicyRequest
+	14, // 71: proto.Casbin.HasNamedPolicy:input_type -> proto.PolicyRequest
+	14, // 72: proto.Casbin.HasGroupingPolicy:input_type -> proto.PolicyRequest
+	14, // 73: proto.Casbin.HasNamedGroupingPolicy:input_type -> proto.PolicyRequest
+	23, // 74: proto.Casbin.GetDomains:input_type -> proto.UserRoleRequest
+	23, // 75: proto.Casbin.GetRolesForUser:input_type -> proto.UserRoleRequest
+	23, // 76: proto.Casbin.GetImplicitRolesForUser:input_type -> proto.UserRoleRequest
+	23, // 77: proto.Casbin.GetUsersForRole:input_type -> proto.UserRoleRequest
+	23, // 78: proto.Casbin.HasRoleForUser:input_type -> proto.UserRoleRequest
+	23, // 79: proto.Casbin.AddRoleForUser:input_type -> proto.UserRoleRequest
+	23, // 80: proto.Casbin.DeleteRoleForUser:input_type -> proto.UserRoleRequest
+	23, // 81: proto.Casbin.DeleteRolesForUser:input_type -> proto.UserRoleRequest
+	23, // 82: proto.Casbin.DeleteUser:input_type -> proto.UserRoleRequest
+	23, // 83: proto.Casbin.DeleteRole:input_type -> proto.UserRoleRequest
+	24, // 84: proto.Casbin.GetPermissionsForUser:input_type -> proto.PermissionRequest
+	24, // 85: proto.Casbin.GetImplicitPermissionsForUser:input_type -> proto.PermissionRequest
+	24, // 86: proto.Casbin.DeletePermission:input_type -> proto.PermissionRequest
+	24, // 87: proto.Casbin.AddPermissionForUser:input_type -> proto.PermissionRequest
+	24, // 88: proto.Casbin.DeletePermissionForUser:input_type -> proto.PermissionRequest
+	24, // 89: proto.Casbin.DeletePermissionsForUser:input_type -> proto.PermissionRequest
+	24, // 90: proto.Casbin.HasPermissionForUser:input_type -> proto.PermissionRequest
+	2,  // 91: proto.Casbin.NewEnforcer:output_type -> proto.NewEnforcerReply
+	6,  // 92: proto.Casbin.NewAdapter:output_type -> proto.NewAdapterReply
+	13, // 93: proto.Casbin.FreeEnforcer:output_type -> proto.EmptyReply
+	13, // 94: proto.Casbin.FreeAdapter:output_type -> proto.EmptyReply
+	2,  // 95: proto.Casbin.GetEnforcerByName:output_type -> proto.NewEnforcerReply
+	4,  // 96: proto.Casbin.ListEnforcers:output_type -> proto.EnforcerListReply
+	9,  // 97: proto.Casbin.Enforce:output_type -> proto.BoolReply
+	10, // 98: proto.Casbin.BatchEnforce:output_type -> proto.BatchBoolReply
+	11, // 99: proto.Casbin.EnforceEx:output_type -> proto.EnforceExReply
+	13, // 100: proto.Casbin.LoadPolicy:output_type -> proto.EmptyReply
+	13, // 101: proto.Casbin.SavePolicy:output_type -> proto.EmptyReply
+	19, // 102: proto.Casbin.WatchPolicy:output_type -> proto.PolicyEvent
+	9,  // 103: proto.Casbin.AddPolicy:output_type -> proto.BoolReply
+	9,  // 104: proto.Casbin.AddNamedPolicy:output_type -> proto.BoolReply
+	9,  // 105: proto.Casbin.RemovePolicy:output_type -> proto.BoolReply
+	9,  // 106: proto.Casbin.RemoveNamedPolicy:output_type -> proto.BoolReply
+	9,  // 107: proto.Casbin.RemoveFilteredPolicy:output_type -> proto.BoolReply
+	9,  // 108: proto.Casbin.RemoveFilteredNamedPolicy:output_type -> proto.BoolReply
+	25, // 109: proto.Casbin.GetPolicy:output_type -> proto.Array2DReply
+	25, // 110: proto.Casbin.GetNamedPolicy:output_type -> proto.Array2DReply
+	25, // 111: proto.Casbin.GetFilteredPolicy:output_type -> proto.Array2DReply
+	25, // 112: proto.Casbin.GetFilteredNamedPolicy:output_type -> proto.Array2DReply
+	9,  // 113: proto.Casbin.AddGroupingPolicy:output_type -> proto.BoolReply
+	9,  // 114: proto.Casbin.AddNamedGroupingPolicy:output_type -> proto.BoolReply
+	9,  // 115: proto.Casbin.RemoveGroupingPolicy:output_type -> proto.BoolReply
+	9,  // 116: proto.Casbin.RemoveNamedGroupingPolicy:output_type -> proto.BoolReply
+	9,  // 117: proto.Casbin.RemoveFilteredGroupingPolicy:output_type -> proto.BoolReply
+	9,  // 118: proto.Casbin.RemoveFilteredNamedGroupingPolicy:output_type -> proto.BoolReply
+	9,  // 119: proto.Casbin.AddPolicies:output_type -> proto.BoolReply
+	9,  // 120: proto.Casbin.AddNamedPolicies:output_type -> proto.BoolReply
+	9,  // 121: proto.Casbin.RemovePolicies:output_type -> proto.BoolReply
+	9,  // 122: proto.Casbin.RemoveNamedPolicies:output_type -> proto.BoolReply
+	9,  // 123: proto.Casbin.AddGroupingPolicies:output_type -> proto.BoolReply
+	9,  // 124: proto.Casbin.AddNamedGroupingPolicies:output_type -> proto.BoolReply
+	9,  // 125: proto.Casbin.RemoveGroupingPolicies:output_type -> proto.BoolReply
+	9,  // 126: proto.Casbin.RemoveNamedGroupingPolicies:output_type -> proto.BoolReply
+	9,  // 127: proto.Casbin.UpdatePolicy:output_type -> proto.BoolReply
+	9,  // 128: proto.Casbin.UpdateNamedPolicy:output_type -> proto.BoolReply
+	9,  // 129: proto.Casbin.UpdatePolicies:output_type -> proto.BoolReply
+	9,  // 130: proto.Casbin.UpdateNamedPolicies:output_type -> proto.BoolReply
+	9,  // 131: proto.Casbin.UpdateFilteredPolicies:output_type -> proto.BoolReply
+	9,  // 132: proto.Casbin.UpdateFilteredNamedPolicies:output_type -> proto.BoolReply
+	9,  // 133: proto.Casbin.UpdateGroupingPolicy:output_type -> proto.BoolReply
+	9,  // 134: proto.Casbin.UpdateNamedGroupingPolicy:output_type -> proto.BoolReply
+	9,  // 135: proto.Casbin.UpdateGroupingPolicies:output_type -> proto.BoolReply
+	9,  // 136: proto.Casbin.UpdateNamedGroupingPolicies:output_type -> proto.BoolReply
+	25, // 137: proto.Casbin.GetGroupingPolicy:output_type -> proto.Array2DReply
+	25, // 138: proto.Casbin.GetNamedGroupingPolicy:output_type -> proto.Array2DReply
+	25, // 139: proto.Casbin.GetFilteredGroupingPolicy:output_type -> proto.Array2DReply
+	25, // 140: proto.Casbin.GetFilteredNamedGroupingPolicy:output_type -> proto.Array2DReply
+	21, // 141: proto.Casbin.GetAllSubjects:output_type -> proto.ArrayReply
+	21, // 142: proto.Casbin.GetAllNamedSubjects:output_type -> proto.ArrayReply
+	21, // 143: proto.Casbin.GetAllObjects:output_type -> proto.ArrayReply
+	21, // 144: proto.Casbin.GetAllNamedObjects:output_type -> proto.ArrayReply
+	21, // 145: proto.Casbin.GetAllActions:output_type -> proto.ArrayReply
+	21, // 146: proto.Casbin.GetAllNamedActions:output_type -> proto.ArrayReply
+	21, // 147: proto.Casbin.GetAllRoles:output_type -> proto.ArrayReply
+	21, // 148: proto.Casbin.GetAllNamedRoles:output_type -> proto.ArrayReply
+	9,  // 149: proto.Casbin.HasPolicy:output_type -> proto.BoolReply
+	9,  // 150: proto.Casbin.HasNamedPolicy:output_type -> proto.BoolReply
+	9,  // 151: proto.Casbin.HasGroupingPolicy:output_type -> proto.BoolReply
+	9,  // 152: proto.Casbin.HasNamedGroupingPolicy:output_type -> proto.BoolReply
+	21, // 153: proto.Casbin.GetDomains:output_type -> proto.ArrayReply
+	21, // 154: proto.Casbin.GetRolesForUser:output_type -> proto.ArrayReply
+	21, // 155: proto.Casbin.GetImplicitRolesForUser:output_type -> proto.ArrayReply
+	21, // 156: proto.Casbin.GetUsersForRole:output_type -> proto.ArrayReply
+	9,  // 157: proto.Casbin.HasRoleForUser:output_type -> proto.BoolReply
+	9,  // 158: proto.Casbin.AddRoleForUser:output_type -> proto.BoolReply
+	9,  // 159: proto.Casbin.DeleteRoleForUser:output_type -> proto.BoolReply
+	9,  // 160: proto.Casbin.DeleteRolesForUser:output_type -> proto.BoolReply
+	9,  // 161: proto.Casbin.DeleteUser:output_type -> proto.BoolReply
+	13, // 162: proto.Casbin.DeleteRole:output_type -> proto.EmptyReply
+	25, // 163: proto.Casbin.GetPermissionsForUser:output_type -> proto.Array2DReply
+	25, // 164: proto.Casbin.GetImplicitPermissionsForUser:output_type -> proto.Array2DReply
+	9,  // 165: proto.Casbin.DeletePermission:output_type -> proto.BoolReply
+	9,  // 166: proto.Casbin.AddPermissionForUser:output_type -> proto.BoolReply
+	9,  // 167: proto.Casbin.DeletePermissionForUser:output_type -> proto.BoolReply
+	9,  // 168: proto.Casbin.DeletePermissionsForUser:output_type -> proto.BoolReply
+	9,  // 169: proto.Casbin.HasPermissionForUser:output_type -> proto.BoolReply
+	91, // [91:170] is the sub-list for method output_type
+	12, // [12:91] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_proto_casbin_proto_init() }
@@ -1418,7 +2597,7 @@ func file_proto_casbin_proto_init() {
 			}
 		}
 		file_proto_casbin_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NewAdapterRequest); i {
+			switch v := v.(*EnforcerNameRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1430,7 +2609,7 @@ func file_proto_casbin_proto_init() {
 			}
 		}
 		file_proto_casbin_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NewAdapterReply); i {
+			switch v := v.(*EnforcerListReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1442,7 +2621,7 @@ func file_proto_casbin_proto_init() {
 			}
 		}
 		file_proto_casbin_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnforceRequest); i {
+			switch v := v.(*NewAdapterRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1454,7 +2633,7 @@ func file_proto_casbin_proto_init() {
 			}
 		}
 		file_proto_casbin_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BoolReply); i {
+			switch v := v.(*NewAdapterReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1466,7 +2645,7 @@ func file_proto_casbin_proto_init() {
 			}
 		}
 		file_proto_casbin_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EmptyRequest); i {
+			switch v := v.(*EnforceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1478,7 +2657,7 @@ func file_proto_casbin_proto_init() {
 			}
 		}
 		file_proto_casbin_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EmptyReply); i {
+			switch v := v.(*BatchEnforceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1490,7 +2669,7 @@ func file_proto_casbin_proto_init() {
 			}
 		}
 		file_proto_casbin_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PolicyRequest); i {
+			switch v := v.(*BoolReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1502,7 +2681,7 @@ func file_proto_casbin_proto_init() {
 			}
 		}
 		file_proto_casbin_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SimpleGetRequest); i {
+			switch v := v.(*BatchBoolReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1514,7 +2693,7 @@ func file_proto_casbin_proto_init() {
 			}
 		}
 		file_proto_casbin_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ArrayReply); i {
+			switch v := v.(*EnforceExReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1526,7 +2705,7 @@ func file_proto_casbin_proto_init() {
 			}
 		}
 		file_proto_casbin_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FilteredPolicyRequest); i {
+			switch v := v.(*EmptyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1538,7 +2717,7 @@ func file_proto_casbin_proto_init() {
 			}
 		}
 		file_proto_casbin_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserRoleRequest); i {
+			switch v := v.(*EmptyReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1550,7 +2729,7 @@ func file_proto_casbin_proto_init() {
 			}
 		}
 		file_proto_casbin_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PermissionRequest); i {
+			switch v := v.(*PolicyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1562,7 +2741,7 @@ func file_proto_casbin_proto_init() {
 			}
 		}
 		file_proto_casbin_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Array2DReply); i {
+			switch v := v.(*PoliciesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1574,6 +2753,162 @@ func file_proto_casbin_proto_init() {
 			}
 		}
 		file_proto_casbin_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdatePolicyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_casbin_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdatePoliciesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_casbin_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateFilteredPoliciesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_casbin_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PolicyEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_casbin_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SimpleGetRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_casbin_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArrayReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_casbin_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FilteredPolicyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_casbin_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserRoleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_casbin_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PermissionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_casbin_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Array2DReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_casbin_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnforcerListReplyEnforcer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_casbin_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchEnforceRequestRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_casbin_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PoliciesRequestRule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_casbin_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Array2DReplyD); i {
 			case 0:
 				return &v.state
@@ -1591,13 +2926,14 @@ func file_proto_casbin_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_casbin_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   16,
+			NumEnums:      1,
+			NumMessages:   29,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_proto_casbin_proto_goTypes,
 		DependencyIndexes: file_proto_casbin_proto_depIdxs,
+		EnumInfos:         file_proto_casbin_proto_enumTypes,
 		MessageInfos:      file_proto_casbin_proto_msgTypes,
 	}.Build()
 	File_proto_casbin_proto = out.File
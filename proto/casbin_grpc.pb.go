@@ -24,9 +24,16 @@ const _ = grpc.SupportPackageIsVersion7
 type CasbinClient interface {
 	NewEnforcer(ctx context.Context, in *NewEnforcerRequest, opts ...grpc.CallOption) (*NewEnforcerReply, error)
 	NewAdapter(ctx context.Context, in *NewAdapterRequest, opts ...grpc.CallOption) (*NewAdapterReply, error)
+	FreeEnforcer(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*EmptyReply, error)
+	FreeAdapter(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*EmptyReply, error)
+	GetEnforcerByName(ctx context.Context, in *EnforcerNameRequest, opts ...grpc.CallOption) (*NewEnforcerReply, error)
+	ListEnforcers(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*EnforcerListReply, error)
 	Enforce(ctx context.Context, in *EnforceRequest, opts ...grpc.CallOption) (*BoolReply, error)
+	BatchEnforce(ctx context.Context, in *BatchEnforceRequest, opts ...grpc.CallOption) (*BatchBoolReply, error)
+	EnforceEx(ctx context.Context, in *EnforceRequest, opts ...grpc.CallOption) (*EnforceExReply, error)
 	LoadPolicy(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*EmptyReply, error)
 	SavePolicy(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*EmptyReply, error)
+	WatchPolicy(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (Casbin_WatchPolicyClient, error)
 	AddPolicy(ctx context.Context, in *PolicyRequest, opts ...grpc.CallOption) (*BoolReply, error)
 	AddNamedPolicy(ctx context.Context, in *PolicyRequest, opts ...grpc.CallOption) (*BoolReply, error)
 	RemovePolicy(ctx context.Context, in *PolicyRequest, opts ...grpc.CallOption) (*BoolReply, error)
@@ -43,6 +50,24 @@ type CasbinClient interface {
 	RemoveNamedGroupingPolicy(ctx context.Context, in *PolicyRequest, opts ...grpc.CallOption) (*BoolReply, error)
 	RemoveFilteredGroupingPolicy(ctx context.Context, in *FilteredPolicyRequest, opts ...grpc.CallOption) (*BoolReply, error)
 	RemoveFilteredNamedGroupingPolicy(ctx context.Context, in *FilteredPolicyRequest, opts ...grpc.CallOption) (*BoolReply, error)
+	AddPolicies(ctx context.Context, in *PoliciesRequest, opts ...grpc.CallOption) (*BoolReply, error)
+	AddNamedPolicies(ctx context.Context, in *PoliciesRequest, opts ...grpc.CallOption) (*BoolReply, error)
+	RemovePolicies(ctx context.Context, in *PoliciesRequest, opts ...grpc.CallOption) (*BoolReply, error)
+	RemoveNamedPolicies(ctx context.Context, in *PoliciesRequest, opts ...grpc.CallOption) (*BoolReply, error)
+	AddGroupingPolicies(ctx context.Context, in *PoliciesRequest, opts ...grpc.CallOption) (*BoolReply, error)
+	AddNamedGroupingPolicies(ctx context.Context, in *PoliciesRequest, opts ...grpc.CallOption) (*BoolReply, error)
+	RemoveGroupingPolicies(ctx context.Context, in *PoliciesRequest, opts ...grpc.CallOption) (*BoolReply, error)
+	RemoveNamedGroupingPolicies(ctx context.Context, in *PoliciesRequest, opts ...grpc.CallOption) (*BoolReply, error)
+	UpdatePolicy(ctx context.Context, in *UpdatePolicyRequest, opts ...grpc.CallOption) (*BoolReply, error)
+	UpdateNamedPolicy(ctx context.Context, in *UpdatePolicyRequest, opts ...grpc.CallOption) (*BoolReply, error)
+	UpdatePolicies(ctx context.Context, in *UpdatePoliciesRequest, opts ...grpc.CallOption) (*BoolReply, error)
+	UpdateNamedPolicies(ctx context.Context, in *UpdatePoliciesRequest, opts ...grpc.CallOption) (*BoolReply, error)
+	UpdateFilteredPolicies(ctx context.Context, in *UpdateFilteredPoliciesRequest, opts ...grpc.CallOption) (*BoolReply, error)
+	UpdateFilteredNamedPolicies(ctx context.Context, in *UpdateFilteredPoliciesRequest, opts ...grpc.CallOption) (*BoolReply, error)
+	UpdateGroupingPolicy(ctx context.Context, in *UpdatePolicyRequest, opts ...grpc.CallOption) (*BoolReply, error)
+	UpdateNamedGroupingPolicy(ctx context.Context, in *UpdatePolicyRequest, opts ...grpc.CallOption) (*BoolReply, error)
+	UpdateGroupingPolicies(ctx context.Context, in *UpdatePoliciesRequest, opts ...grpc.CallOption) (*BoolReply, error)
+	UpdateNamedGroupingPolicies(ctx context.Context, in *UpdatePoliciesRequest, opts ...grpc.CallOption) (*BoolReply, error)
 	GetGroupingPolicy(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*Array2DReply, error)
 	GetNamedGroupingPolicy(ctx context.Context, in *PolicyRequest, opts ...grpc.CallOption) (*Array2DReply, error)
 	GetFilteredGroupingPolicy(ctx context.Context, in *FilteredPolicyRequest, opts ...grpc.CallOption) (*Array2DReply, error)
@@ -104,6 +129,42 @@ func (c *casbinClient) NewAdapter(ctx context.Context, in *NewAdapterRequest, op
 	return out, nil
 }
 
+func (c *casbinClient) FreeEnforcer(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*EmptyReply, error) {
+	out := new(EmptyReply)
+	err := c.cc.Invoke(ctx, "/proto.Casbin/FreeEnforcer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *casbinClient) FreeAdapter(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*EmptyReply, error) {
+	out := new(EmptyReply)
+	err := c.cc.Invoke(ctx, "/proto.Casbin/FreeAdapter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *casbinClient) GetEnforcerByName(ctx context.Context, in *EnforcerNameRequest, opts ...grpc.CallOption) (*NewEnforcerReply, error) {
+	out := new(NewEnforcerReply)
+	err := c.cc.Invoke(ctx, "/proto.Casbin/GetEnforcerByName", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *casbinClient) ListEnforcers(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*EnforcerListReply, error) {
+	out := new(EnforcerListReply)
+	err := c.cc.Invoke(ctx, "/proto.Casbin/ListEnforcers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *casbinClient) Enforce(ctx context.Context, in *EnforceRequest, opts ...grpc.CallOption) (*BoolReply, error) {
 	out := new(BoolReply)
 	err := c.cc.Invoke(ctx, "/proto.Casbin/Enforce", in, out, opts...)
@@ -113,6 +174,24 @@ func (c *casbinClient) Enforce(ctx context.Context, in *EnforceRequest, opts ...
 	return out, nil
 }
 
+func (c *casbinClient) BatchEnforce(ctx context.Context, in *BatchEnforceRequest, opts ...grpc.CallOption) (*BatchBoolReply, error) {
+	out := new(BatchBoolReply)
+	err := c.cc.Invoke(ctx, "/proto.Casbin/BatchEnforce", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *casbinClient) EnforceEx(ctx context.Context, in *EnforceRequest, opts ...grpc.CallOption) (*EnforceExReply, error) {
+	out := new(EnforceExReply)
+	err := c.cc.Invoke(ctx, "/proto.Casbin/EnforceEx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *casbinClient) LoadPolicy(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*EmptyReply, error) {
 	out := new(EmptyReply)
 	err := c.cc.Invoke(ctx, "/proto.Casbin/LoadPolicy", in, out, opts...)
@@ -131,6 +210,38 @@ func (c *casbinClient) SavePolicy(ctx context.Context, in *EmptyRequest, opts ..
 	return out, nil
 }
 
+func (c *casbinClient) WatchPolicy(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (Casbin_WatchPolicyClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Casbin_ServiceDesc.Streams[0], "/proto.Casbin/WatchPolicy", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &casbinWatchPolicyClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Casbin_WatchPolicyClient interface {
+	Recv() (*PolicyEvent, error)
+	grpc.ClientStream
+}
+
+type casbinWatchPolicyClient struct {
+	grpc.ClientStream
+}
+
+func (x *casbinWatchPolicyClient) Recv() (*PolicyEvent, error) {
+	m := new(PolicyEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *casbinClient) AddPolicy(ctx context.Context, in *PolicyRequest, opts ...grpc.CallOption) (*BoolReply, error) {
 	out := new(BoolReply)
 	err := c.cc.Invoke(ctx, "/proto.Casbin/AddPolicy", in, out, opts...)
@@ -275,6 +386,168 @@ func (c *casbinClient) RemoveFilteredNamedGroupingPolicy(ctx context.Context, in
 	return out, nil
 }
 
+func (c *casbinClient) AddPolicies(ctx context.Context, in *PoliciesRequest, opts ...grpc.CallOption) (*BoolReply, error) {
+	out := new(BoolReply)
+	err := c.cc.Invoke(ctx, "/proto.Casbin/AddPolicies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *casbinClient) AddNamedPolicies(ctx context.Context, in *PoliciesRequest, opts ...grpc.CallOption) (*BoolReply, error) {
+	out := new(BoolReply)
+	err := c.cc.Invoke(ctx, "/proto.Casbin/AddNamedPolicies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *casbinClient) RemovePolicies(ctx context.Context, in *PoliciesRequest, opts ...grpc.CallOption) (*BoolReply, error) {
+	out := new(BoolReply)
+	err := c.cc.Invoke(ctx, "/proto.Casbin/RemovePolicies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *casbinClient) RemoveNamedPolicies(ctx context.Context, in *PoliciesRequest, opts ...grpc.CallOption) (*BoolReply, error) {
+	out := new(BoolReply)
+	err := c.cc.Invoke(ctx, "/proto.Casbin/RemoveNamedPolicies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *casbinClient) AddGroupingPolicies(ctx context.Context, in *PoliciesRequest, opts ...grpc.CallOption) (*BoolReply, error) {
+	out := new(BoolReply)
+	err := c.cc.Invoke(ctx, "/proto.Casbin/AddGroupingPolicies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *casbinClient) AddNamedGroupingPolicies(ctx context.Context, in *PoliciesRequest, opts ...grpc.CallOption) (*BoolReply, error) {
+	out := new(BoolReply)
+	err := c.cc.Invoke(ctx, "/proto.Casbin/AddNamedGroupingPolicies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *casbinClient) RemoveGroupingPolicies(ctx context.Context, in *PoliciesRequest, opts ...grpc.CallOption) (*BoolReply, error) {
+	out := new(BoolReply)
+	err := c.cc.Invoke(ctx, "/proto.Casbin/RemoveGroupingPolicies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *casbinClient) RemoveNamedGroupingPolicies(ctx context.Context, in *PoliciesRequest, opts ...grpc.CallOption) (*BoolReply, error) {
+	out := new(BoolReply)
+	err := c.cc.Invoke(ctx, "/proto.Casbin/RemoveNamedGroupingPolicies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *casbinClient) UpdatePolicy(ctx context.Context, in *UpdatePolicyRequest, opts ...grpc.CallOption) (*BoolReply, error) {
+	out := new(BoolReply)
+	err := c.cc.Invoke(ctx, "/proto.Casbin/UpdatePolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *casbinClient) UpdateNamedPolicy(ctx context.Context, in *UpdatePolicyRequest, opts ...grpc.CallOption) (*BoolReply, error) {
+	out := new(BoolReply)
+	err := c.cc.Invoke(ctx, "/proto.Casbin/UpdateNamedPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *casbinClient) UpdatePolicies(ctx context.Context, in *UpdatePoliciesRequest, opts ...grpc.CallOption) (*BoolReply, error) {
+	out := new(BoolReply)
+	err := c.cc.Invoke(ctx, "/proto.Casbin/UpdatePolicies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *casbinClient) UpdateNamedPolicies(ctx context.Context, in *UpdatePoliciesRequest, opts ...grpc.CallOption) (*BoolReply, error) {
+	out := new(BoolReply)
+	err := c.cc.Invoke(ctx, "/proto.Casbin/UpdateNamedPolicies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *casbinClient) UpdateFilteredPolicies(ctx context.Context, in *UpdateFilteredPoliciesRequest, opts ...grpc.CallOption) (*BoolReply, error) {
+	out := new(BoolReply)
+	err := c.cc.Invoke(ctx, "/proto.Casbin/UpdateFilteredPolicies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *casbinClient) UpdateFilteredNamedPolicies(ctx context.Context, in *UpdateFilteredPoliciesRequest, opts ...grpc.CallOption) (*BoolReply, error) {
+	out := new(BoolReply)
+	err := c.cc.Invoke(ctx, "/proto.Casbin/UpdateFilteredNamedPolicies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *casbinClient) UpdateGroupingPolicy(ctx context.Context, in *UpdatePolicyRequest, opts ...grpc.CallOption) (*BoolReply, error) {
+	out := new(BoolReply)
+	err := c.cc.Invoke(ctx, "/proto.Casbin/UpdateGroupingPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *casbinClient) UpdateNamedGroupingPolicy(ctx context.Context, in *UpdatePolicyRequest, opts ...grpc.CallOption) (*BoolReply, error) {
+	out := new(BoolReply)
+	err := c.cc.Invoke(ctx, "/proto.Casbin/UpdateNamedGroupingPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *casbinClient) UpdateGroupingPolicies(ctx context.Context, in *UpdatePoliciesRequest, opts ...grpc.CallOption) (*BoolReply, error) {
+	out := new(BoolReply)
+	err := c.cc.Invoke(ctx, "/proto.Casbin/UpdateGroupingPolicies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *casbinClient) UpdateNamedGroupingPolicies(ctx context.Context, in *UpdatePoliciesRequest, opts ...grpc.CallOption) (*BoolReply, error) {
+	out := new(BoolReply)
+	err := c.cc.Invoke(ctx, "/proto.Casbin/UpdateNamedGroupingPolicies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *casbinClient) GetGroupingPolicy(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*Array2DReply, error) {
 	out := new(Array2DReply)
 	err := c.cc.Invoke(ctx, "/proto.Casbin/GetGroupingPolicy", in, out, opts...)
@@ -578,9 +851,16 @@ func (c *casbinClient) HasPermissionForUser(ctx context.Context, in *PermissionR
 type CasbinServer interface {
 	NewEnforcer(context.Context, *NewEnforcerRequest) (*NewEnforcerReply, error)
 	NewAdapter(context.Context, *NewAdapterRequest) (*NewAdapterReply, error)
+	FreeEnforcer(context.Context, *EmptyRequest) (*EmptyReply, error)
+	FreeAdapter(context.Context, *EmptyRequest) (*EmptyReply, error)
+	GetEnforcerByName(context.Context, *EnforcerNameRequest) (*NewEnforcerReply, error)
+	ListEnforcers(context.Context, *EmptyRequest) (*EnforcerListReply, error)
 	Enforce(context.Context, *EnforceRequest) (*BoolReply, error)
+	BatchEnforce(context.Context, *BatchEnforceRequest) (*BatchBoolReply, error)
+	EnforceEx(context.Context, *EnforceRequest) (*EnforceExReply, error)
 	LoadPolicy(context.Context, *EmptyRequest) (*EmptyReply, error)
 	SavePolicy(context.Context, *EmptyRequest) (*EmptyReply, error)
+	WatchPolicy(*EmptyRequest, Casbin_WatchPolicyServer) error
 	AddPolicy(context.Context, *PolicyRequest) (*BoolReply, error)
 	AddNamedPolicy(context.Context, *PolicyRequest) (*BoolReply, error)
 	RemovePolicy(context.Context, *PolicyRequest) (*BoolReply, error)
@@ -597,6 +877,24 @@ type CasbinServer interface {
 	RemoveNamedGroupingPolicy(context.Context, *PolicyRequest) (*BoolReply, error)
 	RemoveFilteredGroupingPolicy(context.Context, *FilteredPolicyRequest) (*BoolReply, error)
 	RemoveFilteredNamedGroupingPolicy(context.Context, *FilteredPolicyRequest) (*BoolReply, error)
+	AddPolicies(context.Context, *PoliciesRequest) (*BoolReply, error)
+	AddNamedPolicies(context.Context, *PoliciesRequest) (*BoolReply, error)
+	RemovePolicies(context.Context, *PoliciesRequest) (*BoolReply, error)
+	RemoveNamedPolicies(context.Context, *PoliciesRequest) (*BoolReply, error)
+	AddGroupingPolicies(context.Context, *PoliciesRequest) (*BoolReply, error)
+	AddNamedGroupingPolicies(context.Context, *PoliciesRequest) (*BoolReply, error)
+	RemoveGroupingPolicies(context.Context, *PoliciesRequest) (*BoolReply, error)
+	RemoveNamedGroupingPolicies(context.Context, *PoliciesRequest) (*BoolReply, error)
+	UpdatePolicy(context.Context, *UpdatePolicyRequest) (*BoolReply, error)
+	UpdateNamedPolicy(context.Context, *UpdatePolicyRequest) (*BoolReply, error)
+	UpdatePolicies(context.Context, *UpdatePoliciesRequest) (*BoolReply, error)
+	UpdateNamedPolicies(context.Context, *UpdatePoliciesRequest) (*BoolReply, error)
+	UpdateFilteredPolicies(context.Context, *UpdateFilteredPoliciesRequest) (*BoolReply, error)
+	UpdateFilteredNamedPolicies(context.Context, *UpdateFilteredPoliciesRequest) (*BoolReply, error)
+	UpdateGroupingPolicy(context.Context, *UpdatePolicyRequest) (*BoolReply, error)
+	UpdateNamedGroupingPolicy(context.Context, *UpdatePolicyRequest) (*BoolReply, error)
+	UpdateGroupingPolicies(context.Context, *UpdatePoliciesRequest) (*BoolReply, error)
+	UpdateNamedGroupingPolicies(context.Context, *UpdatePoliciesRequest) (*BoolReply, error)
 	GetGroupingPolicy(context.Context, *EmptyRequest) (*Array2DReply, error)
 	GetNamedGroupingPolicy(context.Context, *PolicyRequest) (*Array2DReply, error)
 	GetFilteredGroupingPolicy(context.Context, *FilteredPolicyRequest) (*Array2DReply, error)
@@ -642,15 +940,36 @@ func (UnimplementedCasbinServer) NewEnforcer(context.Context, *NewEnforcerReques
 func (UnimplementedCasbinServer) NewAdapter(context.Context, *NewAdapterRequest) (*NewAdapterReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NewAdapter not implemented")
 }
+func (UnimplementedCasbinServer) FreeEnforcer(context.Context, *EmptyRequest) (*EmptyReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FreeEnforcer not implemented")
+}
+func (UnimplementedCasbinServer) FreeAdapter(context.Context, *EmptyRequest) (*EmptyReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FreeAdapter not implemented")
+}
+func (UnimplementedCasbinServer) GetEnforcerByName(context.Context, *EnforcerNameRequest) (*NewEnforcerReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEnforcerByName not implemented")
+}
+func (UnimplementedCasbinServer) ListEnforcers(context.Context, *EmptyRequest) (*EnforcerListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEnforcers not implemented")
+}
 func (UnimplementedCasbinServer) Enforce(context.Context, *EnforceRequest) (*BoolReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Enforce not implemented")
 }
+func (UnimplementedCasbinServer) BatchEnforce(context.Context, *BatchEnforceRequest) (*BatchBoolReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchEnforce not implemented")
+}
+func (UnimplementedCasbinServer) EnforceEx(context.Context, *EnforceRequest) (*EnforceExReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnforceEx not implemented")
+}
 func (UnimplementedCasbinServer) LoadPolicy(context.Context, *EmptyRequest) (*EmptyReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LoadPolicy not implemented")
 }
 func (UnimplementedCasbinServer) SavePolicy(context.Context, *EmptyRequest) (*EmptyReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SavePolicy not implemented")
 }
+func (UnimplementedCasbinServer) WatchPolicy(*EmptyRequest, Casbin_WatchPolicyServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchPolicy not implemented")
+}
 func (UnimplementedCasbinServer) AddPolicy(context.Context, *PolicyRequest) (*BoolReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddPolicy not implemented")
 }
@@ -699,6 +1018,60 @@ func (UnimplementedCasbinServer) RemoveFilteredGroupingPolicy(context.Context, *
 func (UnimplementedCasbinServer) RemoveFilteredNamedGroupingPolicy(context.Context, *FilteredPolicyRequest) (*BoolReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveFilteredNamedGroupingPolicy not implemented")
 }
+func (UnimplementedCasbinServer) AddPolicies(context.Context, *PoliciesRequest) (*BoolReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPolicies not implemented")
+}
+func (UnimplementedCasbinServer) AddNamedPolicies(context.Context, *PoliciesRequest) (*BoolReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddNamedPolicies not implemented")
+}
+func (UnimplementedCasbinServer) RemovePolicies(context.Context, *PoliciesRequest) (*BoolReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemovePolicies not implemented")
+}
+func (UnimplementedCasbinServer) RemoveNamedPolicies(context.Context, *PoliciesRequest) (*BoolReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveNamedPolicies not implemented")
+}
+func (UnimplementedCasbinServer) AddGroupingPolicies(context.Context, *PoliciesRequest) (*BoolReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddGroupingPolicies not implemented")
+}
+func (UnimplementedCasbinServer) AddNamedGroupingPolicies(context.Context, *PoliciesRequest) (*BoolReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddNamedGroupingPolicies not implemented")
+}
+func (UnimplementedCasbinServer) RemoveGroupingPolicies(context.Context, *PoliciesRequest) (*BoolReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveGroupingPolicies not implemented")
+}
+func (UnimplementedCasbinServer) RemoveNamedGroupingPolicies(context.Context, *PoliciesRequest) (*BoolReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveNamedGroupingPolicies not implemented")
+}
+func (UnimplementedCasbinServer) UpdatePolicy(context.Context, *UpdatePolicyRequest) (*BoolReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePolicy not implemented")
+}
+func (UnimplementedCasbinServer) UpdateNamedPolicy(context.Context, *UpdatePolicyRequest) (*BoolReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNamedPolicy not implemented")
+}
+func (UnimplementedCasbinServer) UpdatePolicies(context.Context, *UpdatePoliciesRequest) (*BoolReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePolicies not implemented")
+}
+func (UnimplementedCasbinServer) UpdateNamedPolicies(context.Context, *UpdatePoliciesRequest) (*BoolReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNamedPolicies not implemented")
+}
+func (UnimplementedCasbinServer) UpdateFilteredPolicies(context.Context, *UpdateFilteredPoliciesRequest) (*BoolReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFilteredPolicies not implemented")
+}
+func (UnimplementedCasbinServer) UpdateFilteredNamedPolicies(context.Context, *UpdateFilteredPoliciesRequest) (*BoolReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFilteredNamedPolicies not implemented")
+}
+func (UnimplementedCasbinServer) UpdateGroupingPolicy(context.Context, *UpdatePolicyRequest) (*BoolReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroupingPolicy not implemented")
+}
+func (UnimplementedCasbinServer) UpdateNamedGroupingPolicy(context.Context, *UpdatePolicyRequest) (*BoolReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNamedGroupingPolicy not implemented")
+}
+func (UnimplementedCasbinServer) UpdateGroupingPolicies(context.Context, *UpdatePoliciesRequest) (*BoolReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateGroupingPolicies not implemented")
+}
+func (UnimplementedCasbinServer) UpdateNamedGroupingPolicies(context.Context, *UpdatePoliciesRequest) (*BoolReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNamedGroupingPolicies not implemented")
+}
 func (UnimplementedCasbinServer) GetGroupingPolicy(context.Context, *EmptyRequest) (*Array2DReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetGroupingPolicy not implemented")
 }
@@ -792,398 +1165,851 @@ func (UnimplementedCasbinServer) AddPermissionForUser(context.Context, *Permissi
 func (UnimplementedCasbinServer) DeletePermissionForUser(context.Context, *PermissionRequest) (*BoolReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeletePermissionForUser not implemented")
 }
-func (UnimplementedCasbinServer) DeletePermissionsForUser(context.Context, *PermissionRequest) (*BoolReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeletePermissionsForUser not implemented")
+func (UnimplementedCasbinServer) DeletePermissionsForUser(context.Context, *PermissionRequest) (*BoolReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePermissionsForUser not implemented")
+}
+func (UnimplementedCasbinServer) HasPermissionForUser(context.Context, *PermissionRequest) (*BoolReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HasPermissionForUser not implemented")
+}
+
+// UnsafeCasbinServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CasbinServer will
+// result in compilation errors.
+type UnsafeCasbinServer interface {
+	mustEmbedUnimplementedCasbinServer()
+}
+
+func RegisterCasbinServer(s grpc.ServiceRegistrar, srv CasbinServer) {
+	s.RegisterService(&Casbin_ServiceDesc, srv)
+}
+
+func _Casbin_NewEnforcer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NewEnforcerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CasbinServer).NewEnforcer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Casbin/NewEnforcer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CasbinServer).NewEnforcer(ctx, req.(*NewEnforcerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Casbin_NewAdapter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NewAdapterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CasbinServer).NewAdapter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Casbin/NewAdapter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CasbinServer).NewAdapter(ctx, req.(*NewAdapterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Casbin_FreeEnforcer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CasbinServer).FreeEnforcer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Casbin/FreeEnforcer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CasbinServer).FreeEnforcer(ctx, req.(*EmptyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Casbin_FreeAdapter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CasbinServer).FreeAdapter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Casbin/FreeAdapter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CasbinServer).FreeAdapter(ctx, req.(*EmptyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Casbin_GetEnforcerByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnforcerNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CasbinServer).GetEnforcerByName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Casbin/GetEnforcerByName",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CasbinServer).GetEnforcerByName(ctx, req.(*EnforcerNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Casbin_ListEnforcers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CasbinServer).ListEnforcers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Casbin/ListEnforcers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CasbinServer).ListEnforcers(ctx, req.(*EmptyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Casbin_Enforce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnforceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CasbinServer).Enforce(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Casbin/Enforce",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CasbinServer).Enforce(ctx, req.(*EnforceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Casbin_BatchEnforce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchEnforceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CasbinServer).BatchEnforce(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Casbin/BatchEnforce",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CasbinServer).BatchEnforce(ctx, req.(*BatchEnforceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Casbin_EnforceEx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnforceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CasbinServer).EnforceEx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Casbin/EnforceEx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CasbinServer).EnforceEx(ctx, req.(*EnforceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Casbin_LoadPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CasbinServer).LoadPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Casbin/LoadPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CasbinServer).LoadPolicy(ctx, req.(*EmptyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Casbin_SavePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CasbinServer).SavePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Casbin/SavePolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CasbinServer).SavePolicy(ctx, req.(*EmptyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Casbin_WatchPolicy_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(EmptyRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CasbinServer).WatchPolicy(m, &casbinWatchPolicyServer{stream})
+}
+
+type Casbin_WatchPolicyServer interface {
+	Send(*PolicyEvent) error
+	grpc.ServerStream
+}
+
+type casbinWatchPolicyServer struct {
+	grpc.ServerStream
+}
+
+func (x *casbinWatchPolicyServer) Send(m *PolicyEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Casbin_AddPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CasbinServer).AddPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Casbin/AddPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CasbinServer).AddPolicy(ctx, req.(*PolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Casbin_AddNamedPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CasbinServer).AddNamedPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Casbin/AddNamedPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CasbinServer).AddNamedPolicy(ctx, req.(*PolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Casbin_RemovePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CasbinServer).RemovePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Casbin/RemovePolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CasbinServer).RemovePolicy(ctx, req.(*PolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Casbin_RemoveNamedPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CasbinServer).RemoveNamedPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Casbin/RemoveNamedPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CasbinServer).RemoveNamedPolicy(ctx, req.(*PolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Casbin_RemoveFilteredPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FilteredPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CasbinServer).RemoveFilteredPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Casbin/RemoveFilteredPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CasbinServer).RemoveFilteredPolicy(ctx, req.(*FilteredPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Casbin_RemoveFilteredNamedPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FilteredPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CasbinServer).RemoveFilteredNamedPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Casbin/RemoveFilteredNamedPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CasbinServer).RemoveFilteredNamedPolicy(ctx, req.(*FilteredPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Casbin_GetPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CasbinServer).GetPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Casbin/GetPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CasbinServer).GetPolicy(ctx, req.(*EmptyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Casbin_GetNamedPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CasbinServer).GetNamedPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Casbin/GetNamedPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CasbinServer).GetNamedPolicy(ctx, req.(*PolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Casbin_GetFilteredPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FilteredPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CasbinServer).GetFilteredPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Casbin/GetFilteredPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CasbinServer).GetFilteredPolicy(ctx, req.(*FilteredPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Casbin_GetFilteredNamedPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FilteredPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CasbinServer).GetFilteredNamedPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Casbin/GetFilteredNamedPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CasbinServer).GetFilteredNamedPolicy(ctx, req.(*FilteredPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedCasbinServer) HasPermissionForUser(context.Context, *PermissionRequest) (*BoolReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HasPermissionForUser not implemented")
+
+func _Casbin_AddGroupingPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CasbinServer).AddGroupingPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Casbin/AddGroupingPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CasbinServer).AddGroupingPolicy(ctx, req.(*PolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// UnsafeCasbinServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to CasbinServer will
-// result in compilation errors.
-type UnsafeCasbinServer interface {
-	mustEmbedUnimplementedCasbinServer()
+func _Casbin_AddNamedGroupingPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CasbinServer).AddNamedGroupingPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Casbin/AddNamedGroupingPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CasbinServer).AddNamedGroupingPolicy(ctx, req.(*PolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterCasbinServer(s grpc.ServiceRegistrar, srv CasbinServer) {
-	s.RegisterService(&Casbin_ServiceDesc, srv)
+func _Casbin_RemoveGroupingPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CasbinServer).RemoveGroupingPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Casbin/RemoveGroupingPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CasbinServer).RemoveGroupingPolicy(ctx, req.(*PolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Casbin_NewEnforcer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NewEnforcerRequest)
+func _Casbin_RemoveNamedGroupingPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PolicyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CasbinServer).NewEnforcer(ctx, in)
+		return srv.(CasbinServer).RemoveNamedGroupingPolicy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.Casbin/NewEnforcer",
+		FullMethod: "/proto.Casbin/RemoveNamedGroupingPolicy",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CasbinServer).NewEnforcer(ctx, req.(*NewEnforcerRequest))
+		return srv.(CasbinServer).RemoveNamedGroupingPolicy(ctx, req.(*PolicyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Casbin_NewAdapter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NewAdapterRequest)
+func _Casbin_RemoveFilteredGroupingPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FilteredPolicyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CasbinServer).NewAdapter(ctx, in)
+		return srv.(CasbinServer).RemoveFilteredGroupingPolicy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.Casbin/NewAdapter",
+		FullMethod: "/proto.Casbin/RemoveFilteredGroupingPolicy",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CasbinServer).NewAdapter(ctx, req.(*NewAdapterRequest))
+		return srv.(CasbinServer).RemoveFilteredGroupingPolicy(ctx, req.(*FilteredPolicyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Casbin_Enforce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EnforceRequest)
+func _Casbin_RemoveFilteredNamedGroupingPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FilteredPolicyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CasbinServer).Enforce(ctx, in)
+		return srv.(CasbinServer).RemoveFilteredNamedGroupingPolicy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.Casbin/Enforce",
+		FullMethod: "/proto.Casbin/RemoveFilteredNamedGroupingPolicy",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CasbinServer).Enforce(ctx, req.(*EnforceRequest))
+		return srv.(CasbinServer).RemoveFilteredNamedGroupingPolicy(ctx, req.(*FilteredPolicyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Casbin_LoadPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EmptyRequest)
+func _Casbin_AddPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PoliciesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CasbinServer).LoadPolicy(ctx, in)
+		return srv.(CasbinServer).AddPolicies(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.Casbin/LoadPolicy",
+		FullMethod: "/proto.Casbin/AddPolicies",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CasbinServer).LoadPolicy(ctx, req.(*EmptyRequest))
+		return srv.(CasbinServer).AddPolicies(ctx, req.(*PoliciesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Casbin_SavePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EmptyRequest)
+func _Casbin_AddNamedPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PoliciesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CasbinServer).SavePolicy(ctx, in)
+		return srv.(CasbinServer).AddNamedPolicies(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.Casbin/SavePolicy",
+		FullMethod: "/proto.Casbin/AddNamedPolicies",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CasbinServer).SavePolicy(ctx, req.(*EmptyRequest))
+		return srv.(CasbinServer).AddNamedPolicies(ctx, req.(*PoliciesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Casbin_AddPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PolicyRequest)
+func _Casbin_RemovePolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PoliciesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CasbinServer).AddPolicy(ctx, in)
+		return srv.(CasbinServer).RemovePolicies(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.Casbin/AddPolicy",
+		FullMethod: "/proto.Casbin/RemovePolicies",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CasbinServer).AddPolicy(ctx, req.(*PolicyRequest))
+		return srv.(CasbinServer).RemovePolicies(ctx, req.(*PoliciesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Casbin_AddNamedPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PolicyRequest)
+func _Casbin_RemoveNamedPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PoliciesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CasbinServer).AddNamedPolicy(ctx, in)
+		return srv.(CasbinServer).RemoveNamedPolicies(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.Casbin/AddNamedPolicy",
+		FullMethod: "/proto.Casbin/RemoveNamedPolicies",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CasbinServer).AddNamedPolicy(ctx, req.(*PolicyRequest))
+		return srv.(CasbinServer).RemoveNamedPolicies(ctx, req.(*PoliciesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Casbin_RemovePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PolicyRequest)
+func _Casbin_AddGroupingPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PoliciesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CasbinServer).RemovePolicy(ctx, in)
+		return srv.(CasbinServer).AddGroupingPolicies(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.Casbin/RemovePolicy",
+		FullMethod: "/proto.Casbin/AddGroupingPolicies",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CasbinServer).RemovePolicy(ctx, req.(*PolicyRequest))
+		return srv.(CasbinServer).AddGroupingPolicies(ctx, req.(*PoliciesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Casbin_RemoveNamedPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PolicyRequest)
+func _Casbin_AddNamedGroupingPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PoliciesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CasbinServer).RemoveNamedPolicy(ctx, in)
+		return srv.(CasbinServer).AddNamedGroupingPolicies(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.Casbin/RemoveNamedPolicy",
+		FullMethod: "/proto.Casbin/AddNamedGroupingPolicies",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CasbinServer).RemoveNamedPolicy(ctx, req.(*PolicyRequest))
+		return srv.(CasbinServer).AddNamedGroupingPolicies(ctx, req.(*PoliciesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Casbin_RemoveFilteredPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FilteredPolicyRequest)
+func _Casbin_RemoveGroupingPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PoliciesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CasbinServer).RemoveFilteredPolicy(ctx, in)
+		return srv.(CasbinServer).RemoveGroupingPolicies(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.Casbin/RemoveFilteredPolicy",
+		FullMethod: "/proto.Casbin/RemoveGroupingPolicies",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CasbinServer).RemoveFilteredPolicy(ctx, req.(*FilteredPolicyRequest))
+		return srv.(CasbinServer).RemoveGroupingPolicies(ctx, req.(*PoliciesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Casbin_RemoveFilteredNamedPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FilteredPolicyRequest)
+func _Casbin_RemoveNamedGroupingPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PoliciesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CasbinServer).RemoveFilteredNamedPolicy(ctx, in)
+		return srv.(CasbinServer).RemoveNamedGroupingPolicies(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.Casbin/RemoveFilteredNamedPolicy",
+		FullMethod: "/proto.Casbin/RemoveNamedGroupingPolicies",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CasbinServer).RemoveFilteredNamedPolicy(ctx, req.(*FilteredPolicyRequest))
+		return srv.(CasbinServer).RemoveNamedGroupingPolicies(ctx, req.(*PoliciesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Casbin_GetPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EmptyRequest)
+func _Casbin_UpdatePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePolicyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CasbinServer).GetPolicy(ctx, in)
+		return srv.(CasbinServer).UpdatePolicy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.Casbin/GetPolicy",
+		FullMethod: "/proto.Casbin/UpdatePolicy",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CasbinServer).GetPolicy(ctx, req.(*EmptyRequest))
+		return srv.(CasbinServer).UpdatePolicy(ctx, req.(*UpdatePolicyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Casbin_GetNamedPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PolicyRequest)
+func _Casbin_UpdateNamedPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePolicyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CasbinServer).GetNamedPolicy(ctx, in)
+		return srv.(CasbinServer).UpdateNamedPolicy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.Casbin/GetNamedPolicy",
+		FullMethod: "/proto.Casbin/UpdateNamedPolicy",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CasbinServer).GetNamedPolicy(ctx, req.(*PolicyRequest))
+		return srv.(CasbinServer).UpdateNamedPolicy(ctx, req.(*UpdatePolicyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Casbin_GetFilteredPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FilteredPolicyRequest)
+func _Casbin_UpdatePolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePoliciesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CasbinServer).GetFilteredPolicy(ctx, in)
+		return srv.(CasbinServer).UpdatePolicies(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.Casbin/GetFilteredPolicy",
+		FullMethod: "/proto.Casbin/UpdatePolicies",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CasbinServer).GetFilteredPolicy(ctx, req.(*FilteredPolicyRequest))
+		return srv.(CasbinServer).UpdatePolicies(ctx, req.(*UpdatePoliciesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Casbin_GetFilteredNamedPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FilteredPolicyRequest)
+func _Casbin_UpdateNamedPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePoliciesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CasbinServer).GetFilteredNamedPolicy(ctx, in)
+		return srv.(CasbinServer).UpdateNamedPolicies(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.Casbin/GetFilteredNamedPolicy",
+		FullMethod: "/proto.Casbin/UpdateNamedPolicies",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CasbinServer).GetFilteredNamedPolicy(ctx, req.(*FilteredPolicyRequest))
+		return srv.(CasbinServer).UpdateNamedPolicies(ctx, req.(*UpdatePoliciesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Casbin_AddGroupingPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PolicyRequest)
+func _Casbin_UpdateFilteredPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateFilteredPoliciesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CasbinServer).AddGroupingPolicy(ctx, in)
+		return srv.(CasbinServer).UpdateFilteredPolicies(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.Casbin/AddGroupingPolicy",
+		FullMethod: "/proto.Casbin/UpdateFilteredPolicies",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CasbinServer).AddGroupingPolicy(ctx, req.(*PolicyRequest))
+		return srv.(CasbinServer).UpdateFilteredPolicies(ctx, req.(*UpdateFilteredPoliciesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Casbin_AddNamedGroupingPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PolicyRequest)
+func _Casbin_UpdateFilteredNamedPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateFilteredPoliciesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CasbinServer).AddNamedGroupingPolicy(ctx, in)
+		return srv.(CasbinServer).UpdateFilteredNamedPolicies(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.Casbin/AddNamedGroupingPolicy",
+		FullMethod: "/proto.Casbin/UpdateFilteredNamedPolicies",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CasbinServer).AddNamedGroupingPolicy(ctx, req.(*PolicyRequest))
+		return srv.(CasbinServer).UpdateFilteredNamedPolicies(ctx, req.(*UpdateFilteredPoliciesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Casbin_RemoveGroupingPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PolicyRequest)
+func _Casbin_UpdateGroupingPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePolicyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CasbinServer).RemoveGroupingPolicy(ctx, in)
+		return srv.(CasbinServer).UpdateGroupingPolicy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.Casbin/RemoveGroupingPolicy",
+		FullMethod: "/proto.Casbin/UpdateGroupingPolicy",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CasbinServer).RemoveGroupingPolicy(ctx, req.(*PolicyRequest))
+		return srv.(CasbinServer).UpdateGroupingPolicy(ctx, req.(*UpdatePolicyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Casbin_RemoveNamedGroupingPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PolicyRequest)
+func _Casbin_UpdateNamedGroupingPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePolicyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CasbinServer).RemoveNamedGroupingPolicy(ctx, in)
+		return srv.(CasbinServer).UpdateNamedGroupingPolicy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.Casbin/RemoveNamedGroupingPolicy",
+		FullMethod: "/proto.Casbin/UpdateNamedGroupingPolicy",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CasbinServer).RemoveNamedGroupingPolicy(ctx, req.(*PolicyRequest))
+		return srv.(CasbinServer).UpdateNamedGroupingPolicy(ctx, req.(*UpdatePolicyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Casbin_RemoveFilteredGroupingPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FilteredPolicyRequest)
+func _Casbin_UpdateGroupingPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePoliciesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CasbinServer).RemoveFilteredGroupingPolicy(ctx, in)
+		return srv.(CasbinServer).UpdateGroupingPolicies(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.Casbin/RemoveFilteredGroupingPolicy",
+		FullMethod: "/proto.Casbin/UpdateGroupingPolicies",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CasbinServer).RemoveFilteredGroupingPolicy(ctx, req.(*FilteredPolicyRequest))
+		return srv.(CasbinServer).UpdateGroupingPolicies(ctx, req.(*UpdatePoliciesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Casbin_RemoveFilteredNamedGroupingPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FilteredPolicyRequest)
+func _Casbin_UpdateNamedGroupingPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePoliciesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CasbinServer).RemoveFilteredNamedGroupingPolicy(ctx, in)
+		return srv.(CasbinServer).UpdateNamedGroupingPolicies(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/proto.Casbin/RemoveFilteredNamedGroupingPolicy",
+		FullMethod: "/proto.Casbin/UpdateNamedGroupingPolicies",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CasbinServer).RemoveFilteredNamedGroupingPolicy(ctx, req.(*FilteredPolicyRequest))
+		return srv.(CasbinServer).UpdateNamedGroupingPolicies(ctx, req.(*UpdatePoliciesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1797,10 +2623,34 @@ var Casbin_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "NewAdapter",
 			Handler:    _Casbin_NewAdapter_Handler,
 		},
+		{
+			MethodName: "FreeEnforcer",
+			Handler:    _Casbin_FreeEnforcer_Handler,
+		},
+		{
+			MethodName: "FreeAdapter",
+			Handler:    _Casbin_FreeAdapter_Handler,
+		},
+		{
+			MethodName: "GetEnforcerByName",
+			Handler:    _Casbin_GetEnforcerByName_Handler,
+		},
+		{
+			MethodName: "ListEnforcers",
+			Handler:    _Casbin_ListEnforcers_Handler,
+		},
 		{
 			MethodName: "Enforce",
 			Handler:    _Casbin_Enforce_Handler,
 		},
+		{
+			MethodName: "BatchEnforce",
+			Handler:    _Casbin_BatchEnforce_Handler,
+		},
+		{
+			MethodName: "EnforceEx",
+			Handler:    _Casbin_EnforceEx_Handler,
+		},
 		{
 			MethodName: "LoadPolicy",
 			Handler:    _Casbin_LoadPolicy_Handler,
@@ -1873,6 +2723,78 @@ var Casbin_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveFilteredNamedGroupingPolicy",
 			Handler:    _Casbin_RemoveFilteredNamedGroupingPolicy_Handler,
 		},
+		{
+			MethodName: "AddPolicies",
+			Handler:    _Casbin_AddPolicies_Handler,
+		},
+		{
+			MethodName: "AddNamedPolicies",
+			Handler:    _Casbin_AddNamedPolicies_Handler,
+		},
+		{
+			MethodName: "RemovePolicies",
+			Handler:    _Casbin_RemovePolicies_Handler,
+		},
+		{
+			MethodName: "RemoveNamedPolicies",
+			Handler:    _Casbin_RemoveNamedPolicies_Handler,
+		},
+		{
+			MethodName: "AddGroupingPolicies",
+			Handler:    _Casbin_AddGroupingPolicies_Handler,
+		},
+		{
+			MethodName: "AddNamedGroupingPolicies",
+			Handler:    _Casbin_AddNamedGroupingPolicies_Handler,
+		},
+		{
+			MethodName: "RemoveGroupingPolicies",
+			Handler:    _Casbin_RemoveGroupingPolicies_Handler,
+		},
+		{
+			MethodName: "RemoveNamedGroupingPolicies",
+			Handler:    _Casbin_RemoveNamedGroupingPolicies_Handler,
+		},
+		{
+			MethodName: "UpdatePolicy",
+			Handler:    _Casbin_UpdatePolicy_Handler,
+		},
+		{
+			MethodName: "UpdateNamedPolicy",
+			Handler:    _Casbin_UpdateNamedPolicy_Handler,
+		},
+		{
+			MethodName: "UpdatePolicies",
+			Handler:    _Casbin_UpdatePolicies_Handler,
+		},
+		{
+			MethodName: "UpdateNamedPolicies",
+			Handler:    _Casbin_UpdateNamedPolicies_Handler,
+		},
+		{
+			MethodName: "UpdateFilteredPolicies",
+			Handler:    _Casbin_UpdateFilteredPolicies_Handler,
+		},
+		{
+			MethodName: "UpdateFilteredNamedPolicies",
+			Handler:    _Casbin_UpdateFilteredNamedPolicies_Handler,
+		},
+		{
+			MethodName: "UpdateGroupingPolicy",
+			Handler:    _Casbin_UpdateGroupingPolicy_Handler,
+		},
+		{
+			MethodName: "UpdateNamedGroupingPolicy",
+			Handler:    _Casbin_UpdateNamedGroupingPolicy_Handler,
+		},
+		{
+			MethodName: "UpdateGroupingPolicies",
+			Handler:    _Casbin_UpdateGroupingPolicies_Handler,
+		},
+		{
+			MethodName: "UpdateNamedGroupingPolicies",
+			Handler:    _Casbin_UpdateNamedGroupingPolicies_Handler,
+		},
 		{
 			MethodName: "GetGroupingPolicy",
 			Handler:    _Casbin_GetGroupingPolicy_Handler,
@@ -2006,6 +2928,12 @@ var Casbin_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _Casbin_HasPermissionForUser_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchPolicy",
+			Handler:       _Casbin_WatchPolicy_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "proto/casbin.proto",
 }
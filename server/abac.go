@@ -16,25 +16,13 @@ package server
 
 import (
 	"encoding/json"
-	"fmt"
-	"strconv"
 	"unicode"
 )
 
-type AbacAttrList struct {
-	V0      string
-	V1      string
-	V2      string
-	V3      string
-	V4      string
-	V5      string
-	V6      string
-	V7      string
-	V8      string
-	V9      string
-	V10     string
-	nameMap map[string]string
-}
+// AbacAttrList holds the attributes of an ABAC param. JSON types are kept, so numbers,
+// booleans, lists and nested objects can be used in matchers, e.g. r.sub.Age > 18 or
+// r.obj.Owner.Dept == r.sub.Dept.
+type AbacAttrList map[string]interface{}
 
 func toUpperFirstChar(str string) string {
 	for i, v := range str {
@@ -53,49 +41,44 @@ func MakeABAC(obj interface{}) (string, error) {
 
 func resolveABAC(obj string) (AbacAttrList, error) {
 	var jsonMap map[string]interface{}
-	attrList := AbacAttrList{nameMap: map[string]string{}}
 
 	err := json.Unmarshal([]byte(obj[len("ABAC::"):]), &jsonMap)
 	if err != nil {
-		return attrList, err
+		return nil, err
 	}
 
-	i := 0
-	for k, v := range jsonMap {
-		key := toUpperFirstChar(k)
-		value := fmt.Sprintf("%v", v)
-		attrList.nameMap[key] = "V" + strconv.Itoa(i)
-		switch i {
-		case 0:
-			attrList.V0 = value
-		case 1:
-			attrList.V1 = value
-		case 2:
-			attrList.V2 = value
-		case 3:
-			attrList.V3 = value
-		case 4:
-			attrList.V4 = value
-		case 5:
-			attrList.V5 = value
-		case 6:
-			attrList.V6 = value
-		case 7:
-			attrList.V7 = value
-		case 8:
-			attrList.V8 = value
-		case 9:
-			attrList.V9 = value
-		case 10:
-			attrList.V10 = value
+	return AbacAttrList(exportAttrs(jsonMap).(map[string]interface{})), nil
+}
+
+// exportAttrs makes every attribute reachable with its first letter capitalized, like the
+// exported field of a Go struct, in addition to its original name. Nested objects, also
+// inside lists, are handled the same way.
+func exportAttrs(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		attrs := make(map[string]interface{}, len(v))
+		for k, attr := range v {
+			attrs[k] = exportAttrs(attr)
+		}
+		for k := range v {
+			if key := toUpperFirstChar(k); key != k {
+				if _, ok := attrs[key]; !ok {
+					attrs[key] = attrs[k]
+				}
+			}
 		}
-		i++
+		return attrs
+	case []interface{}:
+		for i := range v {
+			v[i] = exportAttrs(v[i])
+		}
+		return v
+	default:
+		return v
 	}
-
-	return attrList, nil
 }
 
 func (attr AbacAttrList) GetCacheKey() string {
 	res, _ := MakeABAC(&attr)
 	return res
-}
\ No newline at end of file
+}
@@ -15,13 +15,13 @@
 package server
 
 import (
-	"encoding/json"
+	"context"
 	"errors"
 	"fmt"
 	"net/url"
 	"os"
-	"regexp"
 	"strings"
+	"time"
 
 	pb "github.com/casbin/casbin-server/proto"
 	"github.com/casbin/casbin/v2/persist"
@@ -29,9 +29,16 @@ import (
 	gormadapter "github.com/casbin/gorm-adapter/v3"
 	mongodbadapter "github.com/casbin/mongodb-adapter/v3"
 	redisadapter "github.com/casbin/redis-adapter/v3"
+	"github.com/glebarez/sqlite"
+	"google.golang.org/grpc/codes"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
 )
 
-var errDriverName = errors.New("currently supported DriverName: file | mysql | postgres | mssql")
+// supportedDriverNames are the values of DriverName in NewAdapterRequest.
+var supportedDriverNames = []string{"file", "mysql", "postgres", "mssql", "mongodb", "redis", "sqlite"}
+
+var errDriverName = newError(codes.InvalidArgument, ReasonInvalidDriver, "currently supported DriverName: "+strings.Join(supportedDriverNames, " | "))
 
 func parseRedisUrl(redisURL string) (host, port, username, password string, err error) {
 	if redisURL == "" {
@@ -55,8 +62,10 @@ func parseRedisUrl(redisURL string) (host, port, username, password string, err
 
 func newAdapter(in *pb.NewAdapterRequest) (persist.Adapter, error) {
 	var a persist.Adapter
-	in = checkLocalConfig(in)
-	supportDriverNames := [...]string{"file", "mysql", "postgres", "mssql", "mongodb"}
+	in, err := checkLocalConfig(in)
+	if err != nil {
+		return nil, err
+	}
 
 	switch in.DriverName {
 	case "file":
@@ -71,7 +80,7 @@ func newAdapter(in *pb.NewAdapterRequest) (persist.Adapter, error) {
 		var err error
 		host, port, username, password, err := parseRedisUrl(in.ConnectString)
 		if err != nil {
-			return nil, err
+			return nil, invalidArgumentError(err)
 		}
 		hostWithPort := fmt.Sprintf("%s:%s", host, port)
 
@@ -85,15 +94,14 @@ func newAdapter(in *pb.NewAdapterRequest) (persist.Adapter, error) {
 		if err != nil {
 			return nil, err
 		}
-	default:
-		var support = false
-		for _, driverName := range supportDriverNames {
-			if driverName == in.DriverName {
-				support = true
-				break
-			}
+	case "sqlite":
+		var err error
+		a, err = newSQLiteAdapter(in.ConnectString)
+		if err != nil {
+			return nil, err
 		}
-		if !support {
+	default:
+		if !isSupported(in.DriverName, supportedDriverNames) {
 			return nil, errDriverName
 		}
 
@@ -107,14 +115,71 @@ func newAdapter(in *pb.NewAdapterRequest) (persist.Adapter, error) {
 	return a, nil
 }
 
-func checkLocalConfig(in *pb.NewAdapterRequest) *pb.NewAdapterRequest {
-	cfg := LoadConfiguration(getLocalConfigPath())
+// newSQLiteAdapter creates a gorm adapter storing the policy in the SQLite database at
+// path, which is created if needed, or in memory if path is ":memory:".
+func newSQLiteAdapter(path string) (persist.Adapter, error) {
+	if path == "" {
+		return nil, invalidArgumentError(errors.New("the path of the SQLite database cannot be empty"))
+	}
+	db, err := gorm.Open(sqlite.Open(path), &gorm.Config{Logger: logger.Default.LogMode(logger.Silent)})
+	if err != nil {
+		return nil, err
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, err
+	}
+	// Every connection to ":memory:" opens a new empty database, and SQLite serializes
+	// the writes anyway, so all the queries share a single connection.
+	sqlDB.SetMaxOpenConns(1)
+	a, err := gormadapter.NewAdapterByDB(db)
+	if err != nil {
+		_ = sqlDB.Close()
+		return nil, err
+	}
+	return a, nil
+}
+
+// adapterCall runs f, a call to an adapter such as loading a policy, in a span and
+// records its duration in the metrics, both named after operation.
+func (s *Server) adapterCall(ctx context.Context, operation string, f func() error) error {
+	_, span := tracer.Start(ctx, "adapter."+operation)
+	start := time.Now()
+	err := redactError(f())
+	s.metrics.observeAdapter(operation, err, time.Since(start))
+	endSpan(span, err)
+	return err
+}
+
+// closeAdapter releases the connections held by the adapter, if it holds any.
+func closeAdapter(a persist.Adapter) error {
+	if c, ok := a.(interface{ Close() error }); ok {
+		return c.Close()
+	}
+	return nil
+}
+
+// checkLocalConfig fills in the driver and connection of the config file if the request lacks them.
+func checkLocalConfig(in *pb.NewAdapterRequest) (*pb.NewAdapterRequest, error) {
 	if in.ConnectString == "" || in.DriverName == "" {
+		cfg, err := loadLocalConfig()
+		if err != nil {
+			return nil, err
+		}
 		in.DriverName = cfg.Driver
 		in.ConnectString = cfg.Connection
 		in.DbSpecified = cfg.DBSpecified
 	}
-	return in
+	return in, nil
+}
+
+// loadLocalConfig loads the connection config file, which the requests fall back to.
+func loadLocalConfig() (Config, error) {
+	cfg, err := LoadConnectionConfig(getLocalConfigPath())
+	if err != nil {
+		return Config{}, wrapError(codes.FailedPrecondition, ReasonConfigUnavailable, err)
+	}
+	return cfg, nil
 }
 
 const (
@@ -122,6 +187,12 @@ const (
 	configFilePathEnvironmentVariable = "CONNECTION_CONFIG_PATH"
 )
 
+// ConnectionConfigPath returns the path of the connection config file, set by the
+// CONNECTION_CONFIG_PATH environment variable or config/connection_config.json by default.
+func ConnectionConfigPath() string {
+	return getLocalConfigPath()
+}
+
 func getLocalConfigPath() string {
 	configFilePath := os.Getenv(configFilePathEnvironmentVariable)
 	if configFilePath == "" {
@@ -130,22 +201,15 @@ func getLocalConfigPath() string {
 	return configFilePath
 }
 
+// LoadConfiguration loads the connection config file, or returns an empty Config after
+// printing the error if it is missing or invalid.
+//
+// Deprecated: use LoadConnectionConfig, which reports the problems of the file.
 func LoadConfiguration(file string) Config {
-	//Loads a default config from adapter_config in case a custom adapter isn't provided by the client.
-	//DriverName, ConnectionString, and dbSpecified can be configured in the file. Defaults to 'file' mode.
-
-	configFile, err := os.Open(file)
+	config, err := LoadConnectionConfig(file)
 	if err != nil {
 		fmt.Println(err.Error())
 	}
-	decoder := json.NewDecoder(configFile)
-	config := Config{}
-	decoder.Decode(&config)
-	re := regexp.MustCompile(`\$\b((\w*))\b`)
-	config.Connection = re.ReplaceAllStringFunc(config.Connection, func(s string) string {
-		return os.Getenv(strings.TrimPrefix(s, `$`))
-	})
-
 	return config
 }
 
@@ -154,4 +218,8 @@ type Config struct {
 	Connection  string
 	Enforcer    string
 	DBSpecified bool
+	// Watcher and WatcherConnection configure the watcher of the named enforcers
+	// created without one, like WatcherDriverName in NewEnforcerRequest.
+	Watcher           string
+	WatcherConnection string
 }
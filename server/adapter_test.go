@@ -2,6 +2,7 @@ package server
 
 import (
 	"os"
+	"path/filepath"
 	"testing"
 
 	miniredis "github.com/alicebob/miniredis/v2"
@@ -102,3 +103,28 @@ func TestRedisAdapterConfigReturnDefaultFallback(t *testing.T) {
 	assert.NoError(t, err, "should create file default adapter without error")
 	assert.NotNil(t, a, "adapter should not be nil")
 }
+
+func TestSQLiteAdapter(t *testing.T) {
+	// An in-memory database keeps the policy as long as the adapter is open.
+	e := newTestEngine(t, "sqlite", ":memory:", "../examples/rbac_model.conf")
+	_, err := e.s.AddPolicy(e.ctx, &pb.PolicyRequest{EnforcerHandler: e.h, Params: []string{"alice", "data1", "read"}})
+	assert.NoError(t, err)
+	_, err = e.s.LoadPolicy(e.ctx, &pb.EmptyRequest{Handler: e.h})
+	assert.NoError(t, err)
+	res, err := e.s.Enforce(e.ctx, &pb.EnforceRequest{EnforcerHandler: e.h, Params: []string{"alice", "data1", "read"}})
+	assert.NoError(t, err)
+	assert.True(t, res.Res)
+
+	// A database file keeps it across restarts.
+	path := filepath.Join(t.TempDir(), "casbin.db")
+	e = newTestEngine(t, "sqlite", path, "../examples/rbac_model.conf")
+	_, err = e.s.AddGroupingPolicy(e.ctx, &pb.PolicyRequest{EnforcerHandler: e.h, Params: []string{"bob", "admin"}})
+	assert.NoError(t, err)
+	e = newTestEngine(t, "sqlite", path, "../examples/rbac_model.conf")
+	roles, err := e.s.GetRolesForUser(e.ctx, &pb.UserRoleRequest{EnforcerHandler: e.h, User: "bob"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"admin"}, roles.Array)
+
+	_, err = newAdapter(&pb.NewAdapterRequest{DriverName: "sqlite", ConnectString: filepath.Join(t.TempDir(), "missing", "casbin.db")})
+	assert.Error(t, err)
+}
@@ -0,0 +1,304 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+// AuditConfig configures the audit log of the enforcement decisions and policy changes.
+type AuditConfig struct {
+	Sinks []AuditSinkConfig `json:"sinks"`
+	// EnforceSampleRate is the fraction of the enforcement decisions that are recorded,
+	// 1 by default. The policy changes are always recorded.
+	EnforceSampleRate *float64 `json:"enforceSampleRate"`
+	// KeepDenied records every denied or failed decision, whatever the sample rate.
+	KeepDenied bool `json:"keepDenied"`
+}
+
+// AuditSinkConfig configures where audit records are written, one JSON object per line.
+type AuditSinkConfig struct {
+	// Type is "stdout", "file" to append to Path, or "rotating" to write to Path
+	// and rotate it when it grows too large.
+	Type string `json:"type"`
+	Path string `json:"path"`
+	// MaxSizeMB is the size at which a rotating file is rotated, 100 MB by default.
+	MaxSizeMB int `json:"maxSizeMB"`
+	// MaxBackups and MaxAgeDays limit the rotated files kept, by number and age.
+	// All of them are kept by default.
+	MaxBackups int `json:"maxBackups"`
+	MaxAgeDays int `json:"maxAgeDays"`
+	// Compress gzips the rotated files.
+	Compress bool `json:"compress"`
+}
+
+// LoadAuditConfig reads an AuditConfig from a JSON file.
+func LoadAuditConfig(path string) (*AuditConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	config := &AuditConfig{}
+	if err := json.Unmarshal(data, config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+const (
+	AuditDecision = "decision"
+	AuditMutation = "mutation"
+)
+
+// AuditRecord is an entry of the audit log.
+type AuditRecord struct {
+	Time time.Time `json:"time"`
+	// Type is AuditDecision for an enforcement decision or AuditMutation for an RPC changing a policy.
+	Type string `json:"type"`
+	// Caller is the identity authenticated by Auth, or else the address of the caller.
+	Caller string `json:"caller,omitempty"`
+	// Method is the RPC of a mutation.
+	Method   string `json:"method,omitempty"`
+	Enforcer int    `json:"enforcer"`
+	// Params are the params of a decision, after the ABAC params are resolved.
+	Params []interface{} `json:"params,omitempty"`
+	// Request is the JSON form of the request of a mutation.
+	Request json.RawMessage `json:"request,omitempty"`
+	// Result is whether the request was allowed, or whether the policy was changed.
+	Result bool `json:"result"`
+	// Rule is the policy rule that produced the decision, if any.
+	Rule  []string `json:"rule,omitempty"`
+	Error string   `json:"error,omitempty"`
+}
+
+// AuditSink receives the audit records. It must be safe for concurrent use.
+type AuditSink interface {
+	Write(record *AuditRecord) error
+	Close() error
+}
+
+// jsonLinesSink writes every record as a line of JSON.
+type jsonLinesSink struct {
+	mu sync.Mutex
+	w  io.Writer
+	// c is closed with the sink, if not nil.
+	c io.Closer
+}
+
+// NewJSONLinesSink creates a sink writing the records to w, one JSON object per line.
+// If w is an io.Closer, it is closed with the sink.
+func NewJSONLinesSink(w io.Writer) AuditSink {
+	c, _ := w.(io.Closer)
+	return &jsonLinesSink{w: w, c: c}
+}
+
+func (s *jsonLinesSink) Write(record *AuditRecord) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	data = append(data, '\n')
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, err = s.w.Write(data)
+	return err
+}
+
+func (s *jsonLinesSink) Close() error {
+	if s.c == nil {
+		return nil
+	}
+	return s.c.Close()
+}
+
+func newAuditSink(config *AuditSinkConfig) (AuditSink, error) {
+	switch config.Type {
+	case "stdout":
+		return &jsonLinesSink{w: os.Stdout}, nil
+	case "file":
+		if config.Path == "" {
+			return nil, errors.New("the file audit sink requires path")
+		}
+		f, err := os.OpenFile(config.Path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0600)
+		if err != nil {
+			return nil, err
+		}
+		return NewJSONLinesSink(f), nil
+	case "rotating":
+		if config.Path == "" {
+			return nil, errors.New("the rotating audit sink requires path")
+		}
+		return NewJSONLinesSink(&lumberjack.Logger{
+			Filename:   config.Path,
+			MaxSize:    config.MaxSizeMB,
+			MaxBackups: config.MaxBackups,
+			MaxAge:     config.MaxAgeDays,
+			Compress:   config.Compress,
+		}), nil
+	default:
+		return nil, fmt.Errorf("unknown audit sink %q, currently supported: stdout | file | rotating", config.Type)
+	}
+}
+
+// Audit records the enforcement decisions of a Server, through a hook in the Server,
+// and the RPCs changing a policy, through its interceptor.
+type Audit struct {
+	sinks      []AuditSink
+	sampleRate float64
+	keepDenied bool
+}
+
+// NewAudit creates the sinks of config, and starts recording the decisions of s into them
+// and into the additional sinks.
+func NewAudit(s *Server, config *AuditConfig, sinks ...AuditSink) (*Audit, error) {
+	a := &Audit{sampleRate: 1, keepDenied: config.KeepDenied}
+	if config.EnforceSampleRate != nil {
+		a.sampleRate = *config.EnforceSampleRate
+	}
+	if a.sampleRate < 0 || a.sampleRate > 1 {
+		return nil, fmt.Errorf("invalid enforceSampleRate %v, it must be between 0 and 1", a.sampleRate)
+	}
+
+	for i := range config.Sinks {
+		sink, err := newAuditSink(&config.Sinks[i])
+		if err != nil {
+			a.Close()
+			return nil, err
+		}
+		a.sinks = append(a.sinks, sink)
+	}
+	a.sinks = append(a.sinks, sinks...)
+	if len(a.sinks) == 0 {
+		return nil, errors.New("no audit sink is configured")
+	}
+
+	s.audit = a
+	return a, nil
+}
+
+// Close closes the sinks.
+func (a *Audit) Close() error {
+	var errs []error
+	for _, sink := range a.sinks {
+		errs = append(errs, sink.Close())
+	}
+	return errors.Join(errs...)
+}
+
+func (a *Audit) write(record *AuditRecord) {
+	for _, sink := range a.sinks {
+		if err := sink.Write(record); err != nil {
+			logf("failed to write audit record: %v", err)
+		}
+	}
+}
+
+// auditCaller returns the identity of the caller authenticated by Auth, or else its address.
+func auditCaller(ctx context.Context) string {
+	if identity, ok := IdentityFromContext(ctx); ok {
+		return identity
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		return p.Addr.String()
+	}
+	return ""
+}
+
+// recordDecision records a decision of the enforcer behind handle if it is sampled. a may be nil.
+func (a *Audit) recordDecision(ctx context.Context, handle int, params []interface{}, res bool, rule []string, err error) {
+	if a == nil {
+		return
+	}
+	sampled := a.sampleRate >= 1 || rand.Float64() < a.sampleRate
+	if !sampled && !(a.keepDenied && (!res || err != nil)) {
+		return
+	}
+
+	record := &AuditRecord{
+		Time:     time.Now().UTC(),
+		Type:     AuditDecision,
+		Caller:   auditCaller(ctx),
+		Enforcer: handle,
+		Params:   params,
+		Result:   res,
+		Rule:     rule,
+	}
+	if err != nil {
+		record.Error = err.Error()
+	}
+	a.write(record)
+}
+
+// isMutation tells whether the RPC changes the policy of an enforcer.
+func isMutation(method string) bool {
+	for _, prefix := range []string{"Add", "Remove", "Update", "Delete"} {
+		if strings.HasPrefix(method, prefix) {
+			return true
+		}
+	}
+	return method == "LoadPolicy" || method == "SavePolicy"
+}
+
+// UnaryInterceptor records the RPCs changing a policy, whether they succeed or not. It must
+// run after the interceptor of Auth, so that the callers are identified.
+func (a *Audit) UnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	resp, err := handler(ctx, req)
+
+	method := rpcMethod(info.FullMethod)
+	if !isMutation(method) {
+		return resp, err
+	}
+
+	record := &AuditRecord{
+		Time:   time.Now().UTC(),
+		Type:   AuditMutation,
+		Caller: auditCaller(ctx),
+		Method: method,
+		Result: err == nil,
+	}
+	switch in := req.(type) {
+	case interface{ GetEnforcerHandler() int32 }:
+		record.Enforcer = int(in.GetEnforcerHandler())
+	case interface{ GetHandler() int32 }:
+		record.Enforcer = int(in.GetHandler())
+	}
+	if msg, ok := req.(proto.Message); ok {
+		record.Request, _ = protojson.Marshal(msg)
+	}
+	if reply, ok := resp.(interface{ GetRes() bool }); ok && err == nil {
+		record.Result = reply.GetRes()
+	}
+	if err != nil {
+		record.Error = err.Error()
+	}
+	a.write(record)
+	return resp, err
+}
@@ -0,0 +1,166 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	pb "github.com/casbin/casbin-server/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+type memorySink struct {
+	mu      sync.Mutex
+	records []*AuditRecord
+}
+
+func (s *memorySink) Write(record *AuditRecord) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.records = append(s.records, record)
+	return nil
+}
+
+func (s *memorySink) Close() error {
+	return nil
+}
+
+func (s *memorySink) take() []*AuditRecord {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	records := s.records
+	s.records = nil
+	return records
+}
+
+func TestAudit(t *testing.T) {
+	e := newTestEngine(t, "file", "../examples/rbac_policy.csv", "../examples/rbac_model.conf")
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	sink := &memorySink{}
+	a, err := NewAudit(e.s, &AuditConfig{Sinks: []AuditSinkConfig{{Type: "file", Path: path}}}, sink)
+	assert.NoError(t, err)
+
+	ctx := context.WithValue(e.ctx, identityKey{}, "ci-bot")
+	_, err = e.s.Enforce(ctx, &pb.EnforceRequest{EnforcerHandler: e.h, Params: []string{"alice", "data1", "read"}})
+	assert.NoError(t, err)
+	_, err = e.s.Enforce(ctx, &pb.EnforceRequest{EnforcerHandler: e.h, Params: []string{"bob", "data1", "read"}})
+	assert.NoError(t, err)
+
+	records := sink.take()
+	if assert.Len(t, records, 2) {
+		assert.Equal(t, AuditDecision, records[0].Type)
+		assert.Equal(t, "ci-bot", records[0].Caller)
+		assert.Equal(t, []interface{}{"alice", "data1", "read"}, records[0].Params)
+		assert.True(t, records[0].Result)
+		assert.Equal(t, []string{"alice", "data1", "read"}, records[0].Rule)
+		assert.False(t, records[1].Result)
+		assert.Empty(t, records[1].Rule)
+	}
+
+	call := func(method string, req interface{}, handler grpc.UnaryHandler) error {
+		_, err := a.UnaryInterceptor(ctx, req, &grpc.UnaryServerInfo{FullMethod: "/proto.Casbin/" + method}, handler)
+		return err
+	}
+	addPolicy := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return e.s.AddPolicy(ctx, req.(*pb.PolicyRequest))
+	}
+	assert.NoError(t, call("AddPolicy", &pb.PolicyRequest{EnforcerHandler: e.h, Params: []string{"eve", "data3", "read"}}, addPolicy))
+	assert.Error(t, call("AddPolicy", &pb.PolicyRequest{EnforcerHandler: 42, Params: []string{"eve", "data3", "read"}}, addPolicy))
+	assert.NoError(t, call("GetPolicy", &pb.EmptyRequest{Handler: e.h}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return e.s.GetPolicy(ctx, req.(*pb.EmptyRequest))
+	}))
+	assert.NoError(t, call("LoadPolicy", &pb.EmptyRequest{Handler: e.h}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return e.s.LoadPolicy(ctx, req.(*pb.EmptyRequest))
+	}))
+
+	// Reads are not recorded.
+	records = sink.take()
+	if assert.Len(t, records, 3) {
+		assert.Equal(t, AuditMutation, records[0].Type)
+		assert.Equal(t, "AddPolicy", records[0].Method)
+		assert.Equal(t, "ci-bot", records[0].Caller)
+		assert.Equal(t, int(e.h), records[0].Enforcer)
+		assert.JSONEq(t, `{"pType":"p","params":["eve","data3","read"]}`, string(records[0].Request))
+		assert.True(t, records[0].Result)
+		assert.Empty(t, records[0].Error)
+
+		assert.Equal(t, 42, records[1].Enforcer)
+		assert.False(t, records[1].Result)
+		assert.NotEmpty(t, records[1].Error)
+
+		assert.Equal(t, "LoadPolicy", records[2].Method)
+		assert.True(t, records[2].Result)
+	}
+
+	assert.NoError(t, a.Close())
+	f, err := os.Open(path)
+	assert.NoError(t, err)
+	defer f.Close()
+	lines := 0
+	for scanner := bufio.NewScanner(f); scanner.Scan(); lines++ {
+		record := &AuditRecord{}
+		assert.NoError(t, json.Unmarshal(scanner.Bytes(), record))
+	}
+	assert.Equal(t, 5, lines)
+}
+
+func TestAuditSampling(t *testing.T) {
+	e := newTestEngine(t, "file", "../examples/rbac_policy.csv", "../examples/rbac_model.conf")
+	rate := 0.0
+	sink := &memorySink{}
+	_, err := NewAudit(e.s, &AuditConfig{EnforceSampleRate: &rate, KeepDenied: true}, sink)
+	assert.NoError(t, err)
+
+	for _, params := range [][]string{{"alice", "data1", "read"}, {"bob", "data1", "read"}, {"bob", "data2", "write"}} {
+		_, err = e.s.Enforce(e.ctx, &pb.EnforceRequest{EnforcerHandler: e.h, Params: params})
+		assert.NoError(t, err)
+	}
+
+	records := sink.take()
+	if assert.Len(t, records, 1) {
+		assert.Equal(t, []interface{}{"bob", "data1", "read"}, records[0].Params)
+		assert.False(t, records[0].Result)
+	}
+}
+
+func TestNewAudit(t *testing.T) {
+	s := NewServer()
+	rate := 1.5
+	_, err := NewAudit(s, &AuditConfig{Sinks: []AuditSinkConfig{{Type: "stdout"}}, EnforceSampleRate: &rate})
+	assert.Error(t, err)
+	_, err = NewAudit(s, &AuditConfig{Sinks: []AuditSinkConfig{{Type: "syslog"}}})
+	assert.Error(t, err)
+	_, err = NewAudit(s, &AuditConfig{Sinks: []AuditSinkConfig{{Type: "rotating"}}})
+	assert.Error(t, err)
+	_, err = NewAudit(s, &AuditConfig{})
+	assert.Error(t, err)
+	assert.Nil(t, s.audit)
+
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	a, err := NewAudit(s, &AuditConfig{Sinks: []AuditSinkConfig{{Type: "rotating", Path: path, MaxSizeMB: 1, MaxBackups: 3}}})
+	assert.NoError(t, err)
+	a.recordDecision(context.Background(), 0, []interface{}{"alice"}, true, nil, nil)
+	assert.NoError(t, a.Close())
+	data, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.Contains(t, string(data), `"params":["alice"]`)
+}
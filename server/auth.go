@@ -0,0 +1,254 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"crypto"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"os"
+	"strings"
+
+	"github.com/casbin/casbin/v2"
+	"github.com/casbin/casbin/v2/model"
+	fileadapter "github.com/casbin/casbin/v2/persist/file-adapter"
+	"github.com/golang-jwt/jwt/v4"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// AuthModel is the model used to authorize the callers of the server. A policy
+// grants an identity or a role the RPCs matching a method name, which may use
+// keyMatch wildcards, e.g. "p, ci-bot, Enforce" or "p, admin, *".
+const AuthModel = `
+[request_definition]
+r = sub, method
+
+[policy_definition]
+p = sub, method
+
+[role_definition]
+g = _, _
+
+[policy_effect]
+e = some(where (p.eft == allow))
+
+[matchers]
+m = g(r.sub, p.sub) && keyMatch(r.method, p.method)
+`
+
+const (
+	apiKeyHeader        = "x-api-key"
+	authorizationHeader = "authorization"
+)
+
+var (
+	errUnauthenticated  = newError(codes.Unauthenticated, ReasonUnauthenticated, "missing or invalid credentials")
+	errPermissionDenied = newError(codes.PermissionDenied, ReasonPermissionDenied, "permission denied")
+)
+
+// AuthConfig configures how callers are authenticated and which RPCs they may call.
+type AuthConfig struct {
+	// APIKeys maps the static API keys, sent in the x-api-key header, to the identity of their owner.
+	APIKeys map[string]string `json:"apiKeys"`
+	// JWKSFile is a JSON Web Key Set used to verify the JWTs sent as "authorization: Bearer <token>".
+	// The sub claim of the token is the identity of the caller.
+	JWKSFile string `json:"jwksFile"`
+	Issuer   string `json:"issuer"`
+	Audience string `json:"audience"`
+	// MTLS uses the common name of the verified client certificate as the identity of the caller.
+	MTLS bool `json:"mtls"`
+	// PolicyFile is the CSV policy of AuthModel.
+	PolicyFile string `json:"policyFile"`
+}
+
+// LoadAuthConfig reads an AuthConfig from a JSON file.
+func LoadAuthConfig(path string) (*AuthConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	config := &AuthConfig{}
+	if err := json.Unmarshal(data, config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// Auth authenticates the callers of the server and authorizes each RPC with AuthModel.
+type Auth struct {
+	config   AuthConfig
+	jwtKeys  map[string]crypto.PublicKey
+	enforcer *casbin.Enforcer
+}
+
+// NewAuth creates an Auth from its config.
+func NewAuth(config *AuthConfig) (*Auth, error) {
+	if len(config.APIKeys) == 0 && config.JWKSFile == "" && !config.MTLS {
+		return nil, errors.New("no authentication method is configured")
+	}
+	if config.PolicyFile == "" {
+		return nil, errors.New("policyFile is required")
+	}
+
+	a := &Auth{config: *config}
+	if config.JWKSFile != "" {
+		keys, err := loadJWKS(config.JWKSFile)
+		if err != nil {
+			return nil, err
+		}
+		a.jwtKeys = keys
+	}
+
+	m, err := model.NewModelFromString(AuthModel)
+	if err != nil {
+		return nil, err
+	}
+	a.enforcer, err = casbin.NewEnforcer(m, fileadapter.NewAdapter(config.PolicyFile))
+	if err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+type identityKey struct{}
+
+// IdentityFromContext returns the identity of the caller authenticated by Auth.
+func IdentityFromContext(ctx context.Context) (string, bool) {
+	identity, ok := ctx.Value(identityKey{}).(string)
+	return identity, ok
+}
+
+// authenticate returns the identity of the caller. A credential that is sent but
+// invalid is rejected, even if another method would have succeeded.
+func (a *Auth) authenticate(ctx context.Context) (string, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	if keys := md.Get(apiKeyHeader); len(keys) > 0 && len(a.config.APIKeys) > 0 {
+		return a.authenticateAPIKey(keys[0])
+	}
+
+	if values := md.Get(authorizationHeader); len(values) > 0 && a.jwtKeys != nil {
+		token := values[0]
+		if len(token) < 7 || !strings.EqualFold(token[:7], "bearer ") {
+			return "", errUnauthenticated
+		}
+		return a.authenticateJWT(token[7:])
+	}
+
+	if a.config.MTLS {
+		if p, ok := peer.FromContext(ctx); ok {
+			if info, ok := p.AuthInfo.(credentials.TLSInfo); ok && len(info.State.VerifiedChains) > 0 {
+				if cn := info.State.VerifiedChains[0][0].Subject.CommonName; cn != "" {
+					return cn, nil
+				}
+			}
+		}
+	}
+
+	return "", errUnauthenticated
+}
+
+func (a *Auth) authenticateAPIKey(key string) (string, error) {
+	identity := ""
+	for k, v := range a.config.APIKeys {
+		// Compare every key in constant time so the response time does not leak them.
+		if subtle.ConstantTimeCompare([]byte(k), []byte(key)) == 1 {
+			identity = v
+		}
+	}
+	if identity == "" {
+		return "", errUnauthenticated
+	}
+	return identity, nil
+}
+
+func (a *Auth) authenticateJWT(token string) (string, error) {
+	claims := &jwt.RegisteredClaims{}
+	parser := jwt.NewParser(jwt.WithValidMethods([]string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512"}))
+	_, err := parser.ParseWithClaims(token, claims, func(t *jwt.Token) (interface{}, error) {
+		kid, _ := t.Header["kid"].(string)
+		if key, ok := a.jwtKeys[kid]; ok {
+			return key, nil
+		}
+		return nil, errors.New("unknown key")
+	})
+	if err != nil {
+		return "", errUnauthenticated
+	}
+
+	if a.config.Issuer != "" && !claims.VerifyIssuer(a.config.Issuer, true) {
+		return "", errUnauthenticated
+	}
+	if a.config.Audience != "" && !claims.VerifyAudience(a.config.Audience, true) {
+		return "", errUnauthenticated
+	}
+	if claims.Subject == "" {
+		return "", errUnauthenticated
+	}
+	return claims.Subject, nil
+}
+
+// authorize authenticates the caller of fullMethod and checks that it may call it.
+// It returns ctx with the identity of the caller.
+func (a *Auth) authorize(ctx context.Context, fullMethod string) (context.Context, error) {
+	identity, err := a.authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	method := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+	ok, err := a.enforcer.Enforce(identity, method)
+	if err != nil {
+		// A failure of the server, not a denial, so that clients do not take it for one.
+		return nil, wrapError(codes.Internal, ReasonInternal, err)
+	}
+	if !ok {
+		return nil, errPermissionDenied
+	}
+	return context.WithValue(ctx, identityKey{}, identity), nil
+}
+
+// UnaryInterceptor authorizes unary RPCs.
+func (a *Auth) UnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	ctx, err := a.authorize(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+type authServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authServerStream) Context() context.Context {
+	return s.ctx
+}
+
+// StreamInterceptor authorizes streaming RPCs.
+func (a *Auth) StreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, err := a.authorize(ss.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+	return handler(srv, &authServerStream{ServerStream: ss, ctx: ctx})
+}
@@ -0,0 +1,205 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+const testAuthPolicy = `
+p, ci-bot, Enforce
+p, reader, Get*
+p, admin, *
+g, ci-bot, reader
+`
+
+func newTestAuth(t *testing.T, config *AuthConfig) *Auth {
+	config.PolicyFile = filepath.Join(t.TempDir(), "auth_policy.csv")
+	if err := os.WriteFile(config.PolicyFile, []byte(testAuthPolicy), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	a, err := NewAuth(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return a
+}
+
+// callAuth runs a unary RPC through the interceptor and returns the identity seen by the handler.
+func callAuth(a *Auth, ctx context.Context, method string) (string, error) {
+	info := &grpc.UnaryServerInfo{FullMethod: "/proto.Casbin/" + method}
+	identity, err := a.UnaryInterceptor(ctx, nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		identity, _ := IdentityFromContext(ctx)
+		return identity, nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return identity.(string), nil
+}
+
+func withHeader(key, value string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(key, value))
+}
+
+func TestAuthAPIKey(t *testing.T) {
+	a := newTestAuth(t, &AuthConfig{APIKeys: map[string]string{"ci-key": "ci-bot", "admin-key": "admin"}})
+
+	identity, err := callAuth(a, withHeader("x-api-key", "ci-key"), "Enforce")
+	assert.NoError(t, err)
+	assert.Equal(t, "ci-bot", identity)
+
+	_, err = callAuth(a, withHeader("x-api-key", "ci-key"), "GetPolicy")
+	assert.NoError(t, err)
+
+	_, err = callAuth(a, withHeader("x-api-key", "ci-key"), "RemoveFilteredPolicy")
+	assertErrorReason(t, err, codes.PermissionDenied, ReasonPermissionDenied)
+
+	identity, err = callAuth(a, withHeader("x-api-key", "admin-key"), "RemoveFilteredPolicy")
+	assert.NoError(t, err)
+	assert.Equal(t, "admin", identity)
+
+	_, err = callAuth(a, withHeader("x-api-key", "wrong-key"), "Enforce")
+	assertErrorReason(t, err, codes.Unauthenticated, ReasonUnauthenticated)
+
+	_, err = callAuth(a, context.Background(), "Enforce")
+	assertErrorReason(t, err, codes.Unauthenticated, ReasonUnauthenticated)
+}
+
+func TestAuthJWT(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	jwks, _ := json.Marshal(map[string]interface{}{"keys": []map[string]string{{
+		"kty": "EC", "kid": "key-1", "use": "sig", "crv": "P-256",
+		"x": base64.RawURLEncoding.EncodeToString(key.X.FillBytes(make([]byte, 32))),
+		"y": base64.RawURLEncoding.EncodeToString(key.Y.FillBytes(make([]byte, 32))),
+	}}})
+	jwksFile := filepath.Join(t.TempDir(), "jwks.json")
+	if err := os.WriteFile(jwksFile, jwks, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	a := newTestAuth(t, &AuthConfig{JWKSFile: jwksFile, Issuer: "https://issuer.example", Audience: "casbin-server"})
+
+	sign := func(kid string, claims jwt.RegisteredClaims) context.Context {
+		token := jwt.NewWithClaims(jwt.SigningMethodES256, claims)
+		token.Header["kid"] = kid
+		signed, err := token.SignedString(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return withHeader("authorization", "Bearer "+signed)
+	}
+	valid := jwt.RegisteredClaims{
+		Subject:   "ci-bot",
+		Issuer:    "https://issuer.example",
+		Audience:  jwt.ClaimStrings{"casbin-server"},
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+	}
+
+	identity, err := callAuth(a, sign("key-1", valid), "Enforce")
+	assert.NoError(t, err)
+	assert.Equal(t, "ci-bot", identity)
+
+	_, err = callAuth(a, sign("key-1", valid), "DeleteRole")
+	assertErrorReason(t, err, codes.PermissionDenied, ReasonPermissionDenied)
+
+	_, err = callAuth(a, sign("key-2", valid), "Enforce")
+	assertErrorReason(t, err, codes.Unauthenticated, ReasonUnauthenticated)
+
+	expired := valid
+	expired.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Hour))
+	_, err = callAuth(a, sign("key-1", expired), "Enforce")
+	assertErrorReason(t, err, codes.Unauthenticated, ReasonUnauthenticated)
+
+	otherIssuer := valid
+	otherIssuer.Issuer = "https://other.example"
+	_, err = callAuth(a, sign("key-1", otherIssuer), "Enforce")
+	assertErrorReason(t, err, codes.Unauthenticated, ReasonUnauthenticated)
+
+	otherAudience := valid
+	otherAudience.Audience = jwt.ClaimStrings{"other"}
+	_, err = callAuth(a, sign("key-1", otherAudience), "Enforce")
+	assertErrorReason(t, err, codes.Unauthenticated, ReasonUnauthenticated)
+
+	hmac, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, valid).SignedString([]byte("secret"))
+	_, err = callAuth(a, withHeader("authorization", "Bearer "+hmac), "Enforce")
+	assertErrorReason(t, err, codes.Unauthenticated, ReasonUnauthenticated)
+
+	_, err = callAuth(a, withHeader("authorization", "Basic YWRtaW46YWRtaW4="), "Enforce")
+	assertErrorReason(t, err, codes.Unauthenticated, ReasonUnauthenticated)
+}
+
+func TestAuthMTLS(t *testing.T) {
+	a := newTestAuth(t, &AuthConfig{MTLS: true})
+
+	withCert := func(cn string) context.Context {
+		cert := &x509.Certificate{Subject: pkix.Name{CommonName: cn}}
+		state := tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}}
+		return peer.NewContext(context.Background(), &peer.Peer{AuthInfo: credentials.TLSInfo{State: state}})
+	}
+
+	identity, err := callAuth(a, withCert("admin"), "DeleteRole")
+	assert.NoError(t, err)
+	assert.Equal(t, "admin", identity)
+
+	_, err = callAuth(a, withCert("ci-bot"), "DeleteRole")
+	assertErrorReason(t, err, codes.PermissionDenied, ReasonPermissionDenied)
+
+	// A certificate that was not verified does not authenticate.
+	unverified := tls.ConnectionState{PeerCertificates: []*x509.Certificate{{Subject: pkix.Name{CommonName: "admin"}}}}
+	ctx := peer.NewContext(context.Background(), &peer.Peer{AuthInfo: credentials.TLSInfo{State: unverified}})
+	_, err = callAuth(a, ctx, "DeleteRole")
+	assertErrorReason(t, err, codes.Unauthenticated, ReasonUnauthenticated)
+}
+
+func TestNewAuthErrors(t *testing.T) {
+	_, err := NewAuth(&AuthConfig{PolicyFile: "../examples/auth_policy.csv"})
+	assert.Error(t, err)
+
+	_, err = NewAuth(&AuthConfig{MTLS: true})
+	assert.Error(t, err)
+
+	_, err = NewAuth(&AuthConfig{JWKSFile: "missing.json", PolicyFile: "../examples/auth_policy.csv"})
+	assert.Error(t, err)
+
+	config, err := LoadAuthConfig("../examples/auth_config.json")
+	assert.NoError(t, err)
+	config.PolicyFile = "../examples/auth_policy.csv"
+	_, err = NewAuth(config)
+	assert.NoError(t, err)
+}
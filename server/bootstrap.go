@@ -0,0 +1,334 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+
+	pb "github.com/casbin/casbin-server/proto"
+	"github.com/casbin/casbin/v2"
+	"github.com/casbin/casbin/v2/persist"
+)
+
+// BootstrapConfig declares the adapters and the named enforcers created when the
+// server starts, so that clients can look the enforcers up by name right away. In the
+// file, all the fields may reference environment variables as $VAR and secret files as
+// ${file:path}, see LoadBootstrapConfig.
+type BootstrapConfig struct {
+	// Adapters are referenced by their name in the enforcers. Several enforcers may share one.
+	Adapters  map[string]AdapterConfig  `json:"adapters"`
+	Enforcers map[string]EnforcerConfig `json:"enforcers"`
+}
+
+// AdapterConfig declares an adapter, like NewAdapterRequest.
+type AdapterConfig struct {
+	Driver      string `json:"driver"`
+	Connection  string `json:"connection"`
+	DBSpecified bool   `json:"dbSpecified"`
+}
+
+// EnforcerConfig declares a named enforcer, like NewEnforcerRequest.
+type EnforcerConfig struct {
+	// Model is the path of the model file.
+	Model string `json:"model"`
+	// Adapter is the name of the adapter the policy is loaded from. The policy is empty without one.
+	Adapter string `json:"adapter"`
+	// Watcher and WatcherConnection keep the policy in sync with the other servers, see
+	// WatcherDriverName in NewEnforcerRequest.
+	Watcher           string `json:"watcher"`
+	WatcherConnection string `json:"watcherConnection"`
+	// Cache caches the decisions until the policy changes, CacheSize of them at most,
+	// 10000 if it is 0.
+	Cache                   bool `json:"cache"`
+	CacheSize               int  `json:"cacheSize"`
+	EnableAcceptJsonRequest bool `json:"enableAcceptJsonRequest"`
+}
+
+func (ec EnforcerConfig) cacheSize() int {
+	if ec.CacheSize == 0 {
+		return defaultDecisionCacheSize
+	}
+	return ec.CacheSize
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Bootstrap creates the adapters and enforcers declared in config, in the order of their names.
+func (s *Server) Bootstrap(ctx context.Context, config *BootstrapConfig) error {
+	return redactError(newBootstrap(s).apply(ctx, config))
+}
+
+type bootstrapAdapter struct {
+	config AdapterConfig
+	handle int
+}
+
+type bootstrapEnforcer struct {
+	config    EnforcerConfig
+	modelText string
+	handle    int
+}
+
+type builtEnforcer struct {
+	e         *casbin.SyncedEnforcer
+	w         persist.Watcher
+	c         *decisionCache
+	modelText string
+}
+
+// bootstrap tracks the adapters and enforcers created from a BootstrapConfig, so that
+// applying a new version of the config only recreates those that changed.
+type bootstrap struct {
+	s         *Server
+	adapters  map[string]*bootstrapAdapter
+	enforcers map[string]*bootstrapEnforcer
+}
+
+func newBootstrap(s *Server) *bootstrap {
+	return &bootstrap{s: s, adapters: map[string]*bootstrapAdapter{}, enforcers: map[string]*bootstrapEnforcer{}}
+}
+
+// apply makes the adapters and enforcers match config. The new and changed ones are all
+// built before any is swapped in, so that if one fails, e.g. because its model does not
+// parse, the server is left as it was. The enforcers that are recreated keep their handles.
+func (b *bootstrap) apply(ctx context.Context, config *BootstrapConfig) error {
+	adapters := map[string]persist.Adapter{}
+	enforcers := map[string]*builtEnforcer{}
+	discard := func() {
+		for _, built := range enforcers {
+			if built.w != nil {
+				built.w.Close()
+			}
+		}
+		for _, a := range adapters {
+			_ = closeAdapter(a)
+		}
+	}
+
+	for _, name := range sortedKeys(config.Adapters) {
+		ac := config.Adapters[name]
+		if ac.Driver == "" {
+			discard()
+			return fmt.Errorf("adapter %s: driver is required", name)
+		}
+		if old, ok := b.adapters[name]; ok && old.config == ac {
+			continue
+		}
+
+		var a persist.Adapter
+		err := b.s.adapterCall(ctx, "connect", func() error {
+			var err error
+			a, err = newAdapter(&pb.NewAdapterRequest{
+				DriverName:    ac.Driver,
+				ConnectString: ac.Connection,
+				DbSpecified:   ac.DBSpecified,
+			})
+			return err
+		})
+		if err != nil {
+			discard()
+			return fmt.Errorf("adapter %s: %w", name, adapterError(err))
+		}
+		adapters[name] = a
+	}
+
+	for _, name := range sortedKeys(config.Enforcers) {
+		ec := config.Enforcers[name]
+		if ec.Model == "" {
+			discard()
+			return fmt.Errorf("enforcer %s: model is required", name)
+		}
+		if ec.CacheSize < 0 {
+			discard()
+			return fmt.Errorf("enforcer %s: cacheSize must not be negative", name)
+		}
+		modelText, err := os.ReadFile(ec.Model)
+		if err != nil {
+			discard()
+			return fmt.Errorf("enforcer %s: %w", name, err)
+		}
+
+		var a persist.Adapter
+		if ec.Adapter != "" {
+			if _, ok := config.Adapters[ec.Adapter]; !ok {
+				discard()
+				return fmt.Errorf("enforcer %s: unknown adapter %s", name, ec.Adapter)
+			}
+			a = adapters[ec.Adapter]
+		}
+		if old, ok := b.enforcers[name]; ok && old.config == ec && old.modelText == string(modelText) && a == nil && b.live(name, old.handle) {
+			continue
+		}
+		if a == nil && ec.Adapter != "" {
+			a, err = b.s.getAdapter(b.adapters[ec.Adapter].handle)
+			if err != nil {
+				discard()
+				return fmt.Errorf("enforcer %s: %w", name, err)
+			}
+		}
+
+		e, w, c, err := b.s.newEnforcer(ctx, &pb.NewEnforcerRequest{
+			ModelText:               string(modelText),
+			EnableAcceptJsonRequest: ec.EnableAcceptJsonRequest,
+			Name:                    name,
+			WatcherDriverName:       ec.Watcher,
+			WatcherConnectString:    ec.WatcherConnection,
+			EnableCache:             ec.Cache,
+		}, a, ec.cacheSize())
+		if err != nil {
+			discard()
+			return fmt.Errorf("enforcer %s: %w", name, err)
+		}
+		enforcers[name] = &builtEnforcer{e: e, w: w, c: c, modelText: string(modelText)}
+	}
+
+	reloaded, freed, oldWatchers, err := b.swapEnforcers(config, enforcers)
+	if err != nil {
+		discard()
+		return err
+	}
+	oldAdapters := b.swapAdapters(config, adapters)
+
+	for _, w := range oldWatchers {
+		w.Close()
+	}
+	for _, h := range freed {
+		b.s.closeWatchers(h)
+		b.s.metrics.forgetEnforcer(h)
+	}
+	for _, h := range reloaded {
+		b.s.publishPolicyEvent(h, &pb.PolicyEvent{EventType: pb.PolicyEvent_LOAD})
+	}
+	for _, a := range oldAdapters {
+		if err := b.s.adapterCall(ctx, "close", func() error { return closeAdapter(a) }); err != nil {
+			logf("failed to close a replaced adapter: %v", err)
+		}
+	}
+	return nil
+}
+
+// live tells whether the enforcer created for name is still registered under it.
+func (b *bootstrap) live(name string, handle int) bool {
+	h, err := b.s.getEnforcerHandleByName(name)
+	return err == nil && h == handle
+}
+
+// swapEnforcers registers the built enforcers in place of the previous ones, and frees
+// those removed from config. It returns the handles of the recreated and freed
+// enforcers and the watchers to close.
+func (b *bootstrap) swapEnforcers(config *BootstrapConfig, enforcers map[string]*builtEnforcer) (reloaded, freed []int, oldWatchers []persist.Watcher, err error) {
+	s := b.s
+	s.muE.Lock()
+	defer s.muE.Unlock()
+
+	// A client may have created an enforcer with the name of a new one.
+	for name := range enforcers {
+		if h, found := s.nameMap[name]; found {
+			if old, ok := b.enforcers[name]; !ok || old.handle != h {
+				return nil, nil, nil, fmt.Errorf("enforcer %s: %w", name, errEnforcerNameExists)
+			}
+		}
+	}
+
+	for _, name := range sortedKeys(enforcers) {
+		built := enforcers[name]
+		h, found := s.nameMap[name]
+		if found {
+			if w := s.watcherMap[h]; w != nil {
+				oldWatchers = append(oldWatchers, w)
+			}
+			delete(s.watcherMap, h)
+			delete(s.cacheMap, h)
+			reloaded = append(reloaded, h)
+		} else {
+			h = s.nextEnforcerHandle
+			s.nextEnforcerHandle++
+			s.nameMap[name] = h
+		}
+		s.enforcerMap[h] = built.e
+		if built.w != nil {
+			s.watcherMap[h] = built.w
+		}
+		if built.c != nil {
+			s.cacheMap[h] = built.c
+		}
+		b.enforcers[name] = &bootstrapEnforcer{config: config.Enforcers[name], modelText: built.modelText, handle: h}
+	}
+
+	for name, old := range b.enforcers {
+		if _, ok := config.Enforcers[name]; ok {
+			continue
+		}
+		delete(b.enforcers, name)
+		if h, found := s.nameMap[name]; !found || h != old.handle {
+			continue
+		}
+		if w := s.watcherMap[old.handle]; w != nil {
+			oldWatchers = append(oldWatchers, w)
+		}
+		delete(s.enforcerMap, old.handle)
+		delete(s.watcherMap, old.handle)
+		delete(s.cacheMap, old.handle)
+		delete(s.nameMap, name)
+		freed = append(freed, old.handle)
+	}
+	return reloaded, freed, oldWatchers, nil
+}
+
+// swapAdapters registers the built adapters in place of the previous ones, and unregisters
+// those removed from config. It returns the adapters to close.
+func (b *bootstrap) swapAdapters(config *BootstrapConfig, adapters map[string]persist.Adapter) []persist.Adapter {
+	s := b.s
+	s.muA.Lock()
+	defer s.muA.Unlock()
+
+	var oldAdapters []persist.Adapter
+	for _, name := range sortedKeys(adapters) {
+		if old, ok := b.adapters[name]; ok {
+			if a, ok := s.adapterMap[old.handle]; ok {
+				oldAdapters = append(oldAdapters, a)
+				s.adapterMap[old.handle] = adapters[name]
+				old.config = config.Adapters[name]
+				continue
+			}
+		}
+		h := s.nextAdapterHandle
+		s.nextAdapterHandle++
+		s.adapterMap[h] = adapters[name]
+		b.adapters[name] = &bootstrapAdapter{config: config.Adapters[name], handle: h}
+	}
+
+	for name, old := range b.adapters {
+		if _, ok := config.Adapters[name]; ok {
+			continue
+		}
+		delete(b.adapters, name)
+		if a, ok := s.adapterMap[old.handle]; ok {
+			oldAdapters = append(oldAdapters, a)
+			delete(s.adapterMap, old.handle)
+		}
+	}
+	return oldAdapters
+}
@@ -0,0 +1,154 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	pb "github.com/casbin/casbin-server/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+func writeConfig(t *testing.T, name, content string) string {
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestBootstrap(t *testing.T) {
+	// The paths in the example config are relative to the root of the repository.
+	wd, err := os.Getwd()
+	assert.NoError(t, err)
+	assert.NoError(t, os.Chdir(".."))
+	defer os.Chdir(wd)
+
+	config, err := LoadBootstrapConfig("examples/server_config.yaml")
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	s := NewServer()
+	ctx := context.Background()
+	assert.NoError(t, s.Bootstrap(ctx, config))
+
+	// The enforcers are created in the order of their names.
+	reply, err := s.ListEnforcers(ctx, &pb.EmptyRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, []*pb.EnforcerListReplyEnforcer{{Handler: 0, Name: "abac"}, {Handler: 1, Name: "rbac"}}, reply.Enforcers)
+
+	h, err := s.GetEnforcerByName(ctx, &pb.EnforcerNameRequest{Name: "rbac"})
+	assert.NoError(t, err)
+	res, err := s.Enforce(ctx, &pb.EnforceRequest{EnforcerHandler: h.Handler, Params: []string{"alice", "data2", "read"}})
+	assert.NoError(t, err)
+	assert.True(t, res.Res)
+
+	assert.Equal(t, 1000, s.getCache(int(h.Handler)).size)
+	assert.Nil(t, s.getCache(0))
+}
+
+func TestBootstrapJSON(t *testing.T) {
+	path := writeConfig(t, "config.json", `{
+  "adapters": {"policy": {"driver": "file", "connection": "$POLICY_FILE"}},
+  "enforcers": {"authz": {"model": "../examples/rbac_model.conf", "adapter": "policy"}}
+}`)
+	t.Setenv("POLICY_FILE", "../examples/rbac_policy.csv")
+	config, err := LoadBootstrapConfig(path)
+	assert.NoError(t, err)
+
+	s := NewServer()
+	assert.NoError(t, s.Bootstrap(context.Background(), config))
+	h, err := s.GetEnforcerByName(context.Background(), &pb.EnforcerNameRequest{Name: "authz"})
+	assert.NoError(t, err)
+	res, err := s.Enforce(context.Background(), &pb.EnforceRequest{EnforcerHandler: h.Handler, Params: []string{"alice", "data1", "read"}})
+	assert.NoError(t, err)
+	assert.True(t, res.Res)
+}
+
+func TestBootstrapErrors(t *testing.T) {
+	for _, config := range []*BootstrapConfig{
+		{Adapters: map[string]AdapterConfig{"a": {}}},
+		{Adapters: map[string]AdapterConfig{"a": {Driver: "oracle", Connection: "db"}}},
+		{Enforcers: map[string]EnforcerConfig{"e": {}}},
+		{Enforcers: map[string]EnforcerConfig{"e": {Model: "../examples/missing.conf"}}},
+		{Enforcers: map[string]EnforcerConfig{"e": {Model: "../examples/rbac_model.conf", Adapter: "missing"}}},
+		{Enforcers: map[string]EnforcerConfig{"e": {Model: "../examples/rbac_model.conf", Cache: true, CacheSize: -1}}},
+	} {
+		assert.Error(t, NewServer().Bootstrap(context.Background(), config))
+	}
+
+	_, err := LoadBootstrapConfig(writeConfig(t, "config.yaml", "enforcers: [a"))
+	assert.Error(t, err)
+}
+
+func TestDecisionCache(t *testing.T) {
+	s := NewServer()
+	ctx := context.Background()
+	_, err := s.NewAdapter(ctx, &pb.NewAdapterRequest{DriverName: "file", ConnectString: "../examples/rbac_policy.csv"})
+	assert.NoError(t, err)
+	modelText, err := os.ReadFile("../examples/rbac_model.conf")
+	assert.NoError(t, err)
+	reply, err := s.NewEnforcer(ctx, &pb.NewEnforcerRequest{ModelText: string(modelText), AdapterHandle: 0, EnableCache: true})
+	assert.NoError(t, err)
+	h := reply.Handler
+
+	enforce := func(params ...string) bool {
+		res, err := s.Enforce(ctx, &pb.EnforceRequest{EnforcerHandler: h, Params: params})
+		assert.NoError(t, err)
+		return res.Res
+	}
+	assert.False(t, enforce("eve", "data1", "read"))
+	_, _, cached := s.getCache(int(h)).get("eve\x00data1\x00read")
+	assert.True(t, cached)
+
+	// Changing the policy drops the cached decisions.
+	_, err = s.AddPolicy(ctx, &pb.PolicyRequest{EnforcerHandler: h, Params: []string{"eve", "data1", "read"}})
+	assert.NoError(t, err)
+	assert.True(t, enforce("eve", "data1", "read"))
+	_, err = s.AddGroupingPolicy(ctx, &pb.PolicyRequest{EnforcerHandler: h, Params: []string{"eve", "data2_admin"}})
+	assert.NoError(t, err)
+	assert.True(t, enforce("eve", "data2", "write"))
+	_, err = s.RemoveNamedGroupingPolicy(ctx, &pb.PolicyRequest{EnforcerHandler: h, PType: "g", Params: []string{"eve", "data2_admin"}})
+	assert.NoError(t, err)
+	assert.False(t, enforce("eve", "data2", "write"))
+
+	// A decision made before the cache is invalidated is not stored.
+	c := s.getCache(int(h))
+	_, generation, _ := c.get("k")
+	c.invalidate()
+	c.set("k", generation, cachedDecision{res: true})
+	_, _, cached = c.get("k")
+	assert.False(t, cached)
+
+	// The least recently used decisions are evicted.
+	c = newDecisionCache(2)
+	_, generation, _ = c.get("a")
+	c.set("a", generation, cachedDecision{res: true})
+	c.set("b", generation, cachedDecision{res: true})
+	c.get("a")
+	c.set("c", generation, cachedDecision{res: true})
+	for key, cached := range map[string]bool{"a": true, "b": false, "c": true} {
+		_, _, ok := c.get(key)
+		assert.Equal(t, cached, ok, key)
+	}
+
+	_, err = s.FreeEnforcer(ctx, &pb.EmptyRequest{Handler: h})
+	assert.NoError(t, err)
+	assert.Nil(t, s.getCache(int(h)))
+}
@@ -0,0 +1,108 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"container/list"
+	"strings"
+	"sync"
+)
+
+type cachedDecision struct {
+	res     bool
+	explain []string
+}
+
+// defaultDecisionCacheSize is the number of decisions a cache holds unless configured otherwise.
+const defaultDecisionCacheSize = 10000
+
+// decisionCache holds the decisions of an enforcer until its policy changes. casbin's
+// cached enforcers are not used, as they miss the changes made through most of the
+// management API. As the requests are chosen by the clients, the cache holds at most
+// size decisions, evicting the least recently used ones.
+type decisionCache struct {
+	mu        sync.Mutex
+	size      int
+	decisions map[string]*list.Element
+	// lru holds the cacheEntry values, the most recently used first.
+	lru *list.List
+	// generation is incremented on every invalidation, so that a decision made
+	// before a change of the policy is not stored after it.
+	generation uint64
+}
+
+type cacheEntry struct {
+	key      string
+	decision cachedDecision
+}
+
+func newDecisionCache(size int) *decisionCache {
+	return &decisionCache{size: size, decisions: map[string]*list.Element{}, lru: list.New()}
+}
+
+// cacheKey returns the key of a request. Only requests made of strings are cached.
+func cacheKey(params []interface{}) (string, bool) {
+	var b strings.Builder
+	for i, param := range params {
+		s, ok := param.(string)
+		if !ok {
+			return "", false
+		}
+		if i > 0 {
+			b.WriteByte(0)
+		}
+		b.WriteString(s)
+	}
+	return b.String(), true
+}
+
+func (c *decisionCache) get(key string) (cachedDecision, uint64, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	elem, ok := c.decisions[key]
+	if !ok {
+		return cachedDecision{}, c.generation, false
+	}
+	c.lru.MoveToFront(elem)
+	return elem.Value.(*cacheEntry).decision, c.generation, true
+}
+
+// set stores a decision made at generation, unless the cache was invalidated since.
+func (c *decisionCache) set(key string, generation uint64, d cachedDecision) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.generation != generation {
+		return
+	}
+	if elem, ok := c.decisions[key]; ok {
+		elem.Value.(*cacheEntry).decision = d
+		c.lru.MoveToFront(elem)
+		return
+	}
+	c.decisions[key] = c.lru.PushFront(&cacheEntry{key: key, decision: d})
+	if c.lru.Len() > c.size {
+		oldest := c.lru.Back()
+		c.lru.Remove(oldest)
+		delete(c.decisions, oldest.Value.(*cacheEntry).key)
+	}
+}
+
+func (c *decisionCache) invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.generation++
+	c.decisions = map[string]*list.Element{}
+	c.lru.Init()
+}
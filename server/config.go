@@ -0,0 +1,351 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/casbin/casbin/v2/model"
+	"gopkg.in/yaml.v3"
+)
+
+// ConfigError is a problem found in a config file, at a line of it if it is known.
+type ConfigError struct {
+	File string
+	Line int
+	Msg  string
+}
+
+func (e *ConfigError) Error() string {
+	if e.Line > 0 {
+		return fmt.Sprintf("%s:%d: %s", e.File, e.Line, e.Msg)
+	}
+	return fmt.Sprintf("%s: %s", e.File, e.Msg)
+}
+
+// configFile is a JSON or YAML config file being validated. The problems found are
+// collected in errs, so that they can all be reported at once.
+type configFile struct {
+	path string
+	root *yaml.Node
+	errs []error
+}
+
+func (f *configFile) errorf(line int, format string, args ...interface{}) {
+	f.errs = append(f.errs, &ConfigError{File: f.path, Line: line, Msg: fmt.Sprintf(format, args...)})
+}
+
+func (f *configFile) err() error {
+	return errors.Join(f.errs...)
+}
+
+var yamlErrorLine = regexp.MustCompile(`^yaml: line (\d+): (.*)$`)
+
+// parseConfigFile reads the JSON or YAML file at path into v, a pointer to a struct. It
+// reports the syntax errors, the keys that are not fields of v and the values of the
+// wrong type with their line. JSON is parsed as YAML, of which it is a subset.
+func parseConfigFile(path string, v interface{}) *configFile {
+	f := &configFile{path: path}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		f.errs = append(f.errs, err)
+		return f
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		if m := yamlErrorLine.FindStringSubmatch(err.Error()); m != nil {
+			line, _ := strconv.Atoi(m[1])
+			f.errorf(line, "syntax error: %s", m[2])
+		} else {
+			f.errorf(0, "syntax error: %s", strings.TrimPrefix(err.Error(), "yaml: "))
+		}
+		return f
+	}
+	if len(doc.Content) == 0 {
+		// An empty file.
+		return f
+	}
+	f.root = doc.Content[0]
+
+	f.checkNode(f.root, reflect.TypeOf(v).Elem(), "")
+	if len(f.errs) > 0 {
+		return f
+	}
+	// The keys are matched to the fields like encoding/json does, ignoring the case.
+	normalizeKeys(f.root, reflect.TypeOf(v).Elem())
+	if err := f.root.Decode(v); err != nil {
+		f.errorf(0, "%v", err)
+		return f
+	}
+	if err := expandConfig(reflect.ValueOf(v)); err != nil {
+		f.errorf(0, "%v", err)
+	}
+	return f
+}
+
+// fieldName returns the key of a struct field in a config file.
+func fieldName(field reflect.StructField) string {
+	if name, _, _ := strings.Cut(field.Tag.Get("json"), ","); name != "" {
+		return name
+	}
+	return field.Name
+}
+
+// lookupField returns the field of t matching key, ignoring the case like encoding/json does.
+func lookupField(t reflect.Type, key string) (reflect.StructField, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		if field := t.Field(i); field.IsExported() && strings.EqualFold(fieldName(field), key) {
+			return field, true
+		}
+	}
+	return reflect.StructField{}, false
+}
+
+// normalizeKeys renames the keys of node matching the fields of t to the names yaml.v3
+// decodes them from, the lowercased field names since the fields have no yaml tag.
+func normalizeKeys(node *yaml.Node, t reflect.Type) {
+	if node.Kind == yaml.AliasNode {
+		node = node.Alias
+	}
+	switch t.Kind() {
+	case reflect.Ptr:
+		normalizeKeys(node, t.Elem())
+	case reflect.Struct:
+		if node.Kind != yaml.MappingNode {
+			return
+		}
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if field, ok := lookupField(t, node.Content[i].Value); ok {
+				node.Content[i].Value = strings.ToLower(field.Name)
+				normalizeKeys(node.Content[i+1], field.Type)
+			}
+		}
+	case reflect.Map:
+		if node.Kind != yaml.MappingNode {
+			return
+		}
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			normalizeKeys(node.Content[i+1], t.Elem())
+		}
+	case reflect.Slice:
+		if node.Kind != yaml.SequenceNode {
+			return
+		}
+		for _, item := range node.Content {
+			normalizeKeys(item, t.Elem())
+		}
+	}
+}
+
+func joinKey(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+// checkNode checks that node can be decoded into a value of type t.
+func (f *configFile) checkNode(node *yaml.Node, t reflect.Type, path string) {
+	if node.Kind == yaml.AliasNode {
+		node = node.Alias
+	}
+	if node.Kind == yaml.ScalarNode && node.Tag == "!!null" {
+		return
+	}
+	name := path
+	if name == "" {
+		name = "the config"
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr:
+		f.checkNode(node, t.Elem(), path)
+	case reflect.Struct:
+		if node.Kind != yaml.MappingNode {
+			f.errorf(node.Line, "%s must be an object", name)
+			return
+		}
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+			field, ok := lookupField(t, key.Value)
+			if !ok {
+				f.errorf(key.Line, "unknown key %q", joinKey(path, key.Value))
+				continue
+			}
+			f.checkNode(value, field.Type, joinKey(path, key.Value))
+		}
+	case reflect.Map:
+		if node.Kind != yaml.MappingNode {
+			f.errorf(node.Line, "%s must be an object", name)
+			return
+		}
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			f.checkNode(node.Content[i+1], t.Elem(), joinKey(path, node.Content[i].Value))
+		}
+	case reflect.Slice:
+		if node.Kind != yaml.SequenceNode {
+			f.errorf(node.Line, "%s must be a list", name)
+			return
+		}
+		for i, item := range node.Content {
+			f.checkNode(item, t.Elem(), fmt.Sprintf("%s[%d]", path, i))
+		}
+	case reflect.String:
+		if node.Kind != yaml.ScalarNode || node.Tag != "!!str" {
+			f.errorf(node.Line, "%s must be a string", name)
+			return
+		}
+		f.checkRefs(node, name)
+	case reflect.Bool:
+		if node.Kind != yaml.ScalarNode || node.Tag != "!!bool" {
+			f.errorf(node.Line, "%s must be true or false", name)
+		}
+	case reflect.Int, reflect.Int32, reflect.Int64:
+		if node.Kind != yaml.ScalarNode || node.Tag != "!!int" {
+			f.errorf(node.Line, "%s must be an integer", name)
+		}
+	case reflect.Float64:
+		if node.Kind != yaml.ScalarNode || (node.Tag != "!!int" && node.Tag != "!!float") {
+			f.errorf(node.Line, "%s must be a number", name)
+		}
+	}
+}
+
+// line returns the line of the key at the given path of keys, or 0 if it is not found.
+func (f *configFile) line(keys ...string) int {
+	node, line := f.root, 0
+	for _, key := range keys {
+		if node == nil || node.Kind != yaml.MappingNode {
+			return 0
+		}
+		var next *yaml.Node
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if strings.EqualFold(node.Content[i].Value, key) {
+				next, line = node.Content[i+1], node.Content[i].Line
+			}
+		}
+		node = next
+	}
+	if node == nil {
+		return 0
+	}
+	return line
+}
+
+func isSupported(name string, supported []string) bool {
+	for _, s := range supported {
+		if s == name {
+			return true
+		}
+	}
+	return false
+}
+
+// checkDriver reports a missing or unsupported adapter driver at keys.
+func (f *configFile) checkDriver(driver string, keys ...string) {
+	if driver == "" {
+		f.errorf(f.line(keys[:len(keys)-1]...), "%s is required", strings.Join(keys, "."))
+	} else if !isSupported(driver, supportedDriverNames) {
+		f.errorf(f.line(keys...), "unsupported driver %q, currently supported: %s", driver, strings.Join(supportedDriverNames, " | "))
+	}
+}
+
+// checkWatcher reports an unsupported watcher driver at keys.
+func (f *configFile) checkWatcher(driver string, keys ...string) {
+	if driver != "" && !isSupported(driver, supportedWatcherDriverNames) {
+		f.errorf(f.line(keys...), "unsupported watcher %q, currently supported: %s", driver, strings.Join(supportedWatcherDriverNames, " | "))
+	}
+}
+
+// checkRefs reports the environment variables referenced as $VAR in the value of node
+// that are not set, and the files referenced as ${file:path} that cannot be read.
+func (f *configFile) checkRefs(node *yaml.Node, name string) {
+	for _, m := range reference.FindAllStringSubmatch(node.Value, -1) {
+		if strings.HasPrefix(m[0], "${") {
+			if _, err := os.Stat(m[1]); err != nil {
+				f.errorf(node.Line, "%s references the secret file %s, which cannot be read: %v", name, m[1], err)
+			}
+		} else if _, ok := os.LookupEnv(m[2]); !ok {
+			f.errorf(node.Line, "%s references the environment variable %s, which is not set", name, m[0])
+		}
+	}
+}
+
+// LoadConnectionConfig reads and validates the connection config file at path. All the
+// problems found are reported, with their line when it is known: a missing file, syntax
+// errors, unknown keys, a connection without a driver, an unsupported driver or watcher, environment variables referenced
+// as $VAR that are not set and secret files referenced as ${file:path} that cannot be
+// read. The references, allowed in every field, are replaced by their values.
+func LoadConnectionConfig(path string) (Config, error) {
+	config := Config{}
+	f := parseConfigFile(path, &config)
+	if len(f.errs) > 0 {
+		return Config{}, f.err()
+	}
+
+	// A file without a connection, e.g. only configuring the watcher, needs no driver.
+	if config.Driver != "" || config.Connection != "" {
+		f.checkDriver(config.Driver, "driver")
+	}
+	f.checkWatcher(config.Watcher, "watcher")
+	if err := f.err(); err != nil {
+		return Config{}, err
+	}
+	return config, nil
+}
+
+// LoadBootstrapConfig reads and validates a BootstrapConfig from a YAML or JSON file. All
+// the problems found are reported like LoadConnectionConfig does, as well as model files
+// that are missing or invalid and references to undeclared adapters.
+func LoadBootstrapConfig(path string) (*BootstrapConfig, error) {
+	config := &BootstrapConfig{}
+	f := parseConfigFile(path, config)
+	if len(f.errs) > 0 {
+		return nil, f.err()
+	}
+
+	for _, name := range sortedKeys(config.Adapters) {
+		a := config.Adapters[name]
+		f.checkDriver(a.Driver, "adapters", name, "driver")
+	}
+	for _, name := range sortedKeys(config.Enforcers) {
+		e := config.Enforcers[name]
+		if e.Model == "" {
+			f.errorf(f.line("enforcers", name), "enforcers.%s.model is required", name)
+		} else if _, err := os.Stat(e.Model); err != nil {
+			f.errorf(f.line("enforcers", name, "model"), "%v", err)
+		} else if _, err := model.NewModelFromFile(e.Model); err != nil {
+			f.errorf(f.line("enforcers", name, "model"), "invalid model: %v", err)
+		}
+		if _, ok := config.Adapters[e.Adapter]; e.Adapter != "" && !ok {
+			f.errorf(f.line("enforcers", name, "adapter"), "unknown adapter %q", e.Adapter)
+		}
+		f.checkWatcher(e.Watcher, "enforcers", name, "watcher")
+		if e.CacheSize < 0 {
+			f.errorf(f.line("enforcers", name, "cacheSize"), "enforcers.%s.cacheSize must not be negative", name)
+		}
+	}
+	if err := f.err(); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
@@ -0,0 +1,114 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadConnectionConfig(t *testing.T) {
+	t.Setenv("DB_HOST", "localhost")
+	t.Setenv("DB_PORT", "5432")
+	t.Setenv("DB_USERNAME", "casbin")
+	t.Setenv("DB_NAME", "casbin")
+	t.Setenv("DB_PASSWORD", "secret")
+	files, err := filepath.Glob("../config/connection_config*.json")
+	assert.NoError(t, err)
+	assert.NotEmpty(t, files)
+	for _, file := range files {
+		_, err := LoadConnectionConfig(file)
+		assert.NoError(t, err, file)
+	}
+
+	config, err := LoadConnectionConfig("../config/connection_config_psql_example.json")
+	assert.NoError(t, err)
+	assert.Equal(t, "postgres", config.Driver)
+	assert.Equal(t, "host=localhost port=5432 user=casbin dbname=casbin password=secret", config.Connection)
+	assert.True(t, config.DBSpecified)
+
+	// The keys are matched ignoring the case, like encoding/json does.
+	config, err = LoadConnectionConfig(writeConfig(t, "config.yaml", "DRIVER: file\nconnection: policy.csv\ndbspecified: true\nwatcherConnection: redis:6379\n"))
+	assert.NoError(t, err)
+	assert.Equal(t, Config{Driver: "file", Connection: "policy.csv", DBSpecified: true, WatcherConnection: "redis:6379"}, config)
+
+	// The driver is only required with a connection.
+	config, err = LoadConnectionConfig(writeConfig(t, "config.yaml", "watcher: redis\nwatcherConnection: redis:6379\n"))
+	assert.NoError(t, err)
+	assert.Equal(t, Config{Watcher: "redis", WatcherConnection: "redis:6379"}, config)
+}
+
+func TestLoadConnectionConfigErrors(t *testing.T) {
+	os.Unsetenv("CASBIN_TEST_UNSET")
+	for _, test := range []struct {
+		name, content, err string
+	}{
+		{"config.json", "{\n  \"driver\": \"file\",\n  \"connection\": \"policy.csv\"\n", "config.json:3: syntax error: did not find expected ',' or '}'"},
+		{"config.json", "{\n  \"driver\": \"file\",\n  \"conection\": \"policy.csv\"\n}", `config.json:3: unknown key "conection"`},
+		{"config.yaml", "driver: file\nconnection: [policy.csv]\n", "config.yaml:2: connection must be a string"},
+		{"config.yaml", "driver: file\ndbSpecified: yes please\n", "config.yaml:2: dbSpecified must be true or false"},
+		{"config.yaml", "driver: oracle\nconnection: db\n", `config.yaml:1: unsupported driver "oracle", currently supported: file | mysql | postgres | mssql | mongodb | redis | sqlite`},
+		{"config.yaml", "connection: db\n", "config.yaml: driver is required"},
+		{"config.yaml", "driver: file\nwatcher: etcd\n", `config.yaml:2: unsupported watcher "etcd", currently supported: redis`},
+		{"config.yaml", "driver: mysql\nconnection: root:$CASBIN_TEST_UNSET@tcp(localhost:3306)/\n", "config.yaml:2: connection references the environment variable $CASBIN_TEST_UNSET, which is not set"},
+	} {
+		path := writeConfig(t, test.name, test.content)
+		_, err := LoadConnectionConfig(path)
+		if assert.Error(t, err, test.content) {
+			assert.Equal(t, filepath.Join(filepath.Dir(path), test.err), err.Error())
+		}
+	}
+
+	// All the problems are reported at once.
+	path := writeConfig(t, "config.yaml", "driver: oracle\ncolor: blue\nsize: 1\n")
+	_, err := LoadConnectionConfig(path)
+	if assert.Error(t, err) {
+		assert.Equal(t, path+`:2: unknown key "color"`+"\n"+path+`:3: unknown key "size"`, err.Error())
+	}
+
+	_, err = LoadConnectionConfig(filepath.Join(t.TempDir(), "missing.json"))
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestLoadBootstrapConfigErrors(t *testing.T) {
+	invalid := writeConfig(t, "invalid.conf", "[request_definition]\nr = sub, obj, act\n")
+	for _, test := range []struct {
+		content, err string
+	}{
+		{"adapters:\n  a:\n    connection: db\n", "config.yaml:2: adapters.a.driver is required"},
+		{"adapters:\n  a:\n    driver: file\n    dbspecified: 1\n", "config.yaml:4: adapters.a.dbspecified must be true or false"},
+		{"enforcers:\n  e:\n    adapter: a\n", "config.yaml:2: enforcers.e.model is required"},
+		{"enforcers:\n  e:\n    model: ../examples/rbac_model.conf\n    cache: true\n    modle: x\n", `config.yaml:5: unknown key "enforcers.e.modle"`},
+		{"enforcers:\n  e:\n    model: ../examples/rbac_model.conf\n    watcher: etcd\n", `config.yaml:4: unsupported watcher "etcd", currently supported: redis`},
+		{"enforcers:\n  e:\n    model: " + invalid + "\n", "config.yaml:3: invalid model: "},
+		{"enforcers:\n  e:\n    model: ../examples/rbac_model.conf\n    cacheSize: -1\n", "config.yaml:4: enforcers.e.cacheSize must not be negative"},
+		{"enforcers: [a\n", "config.yaml:1: syntax error: "},
+	} {
+		path := writeConfig(t, "config.yaml", test.content)
+		_, err := LoadBootstrapConfig(path)
+		if assert.Error(t, err, test.content) {
+			assert.Contains(t, err.Error(), filepath.Join(filepath.Dir(path), test.err))
+		}
+	}
+
+	path := writeConfig(t, "config.yaml", "enforcers:\n  e:\n    model: ../examples/missing.conf\n")
+	_, err := LoadBootstrapConfig(path)
+	if assert.Error(t, err) {
+		assert.Equal(t, path+":3: stat ../examples/missing.conf: no such file or directory", err.Error())
+	}
+}
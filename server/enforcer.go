@@ -16,42 +16,76 @@ package server
 
 import (
 	"context"
-	"errors"
 	"os"
+	"runtime"
+	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	pb "github.com/casbin/casbin-server/proto"
 	"github.com/casbin/casbin/v2"
 	"github.com/casbin/casbin/v2/model"
 	"github.com/casbin/casbin/v2/persist"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 // Server is used to implement proto.CasbinServer.
 type Server struct {
-	enforcerMap map[int]*casbin.Enforcer
+	enforcerMap map[int]*casbin.SyncedEnforcer
 	adapterMap  map[int]persist.Adapter
-	muE         sync.RWMutex
-	muA         sync.RWMutex
+	// nameMap maps the names given to enforcers in NewEnforcer to their handles.
+	// It is guarded by muE together with enforcerMap.
+	nameMap map[string]int
+	muE     sync.RWMutex
+	muA     sync.RWMutex
+
+	// nextEnforcerHandle and nextAdapterHandle only ever grow, so a handle
+	// that has been freed is never handed out to another client.
+	nextEnforcerHandle int
+	nextAdapterHandle  int
+
+	// watcherMap holds the watchers keeping enforcers in sync with other servers,
+	// guarded by muE together with enforcerMap.
+	watcherMap map[int]persist.Watcher
+	// cacheMap holds the decision caches of the enforcers created with EnableCache,
+	// guarded by muE together with enforcerMap.
+	cacheMap map[int]*decisionCache
+
+	// watchers are the WatchPolicy streams of each enforcer handle, guarded by muW.
+	watchers map[int]map[*policyWatcher]struct{}
+	muW      sync.Mutex
+
+	// metrics is set by NewMetrics, and is nil if the metrics are not collected.
+	metrics *Metrics
+	// audit is set by NewAudit, and is nil if the decisions are not audited.
+	audit *Audit
 }
 
 func NewServer() *Server {
 	s := Server{}
 
-	s.enforcerMap = map[int]*casbin.Enforcer{}
+	s.enforcerMap = map[int]*casbin.SyncedEnforcer{}
 	s.adapterMap = map[int]persist.Adapter{}
+	s.nameMap = map[string]int{}
+	s.watcherMap = map[int]persist.Watcher{}
+	s.cacheMap = map[int]*decisionCache{}
+	s.watchers = map[int]map[*policyWatcher]struct{}{}
 
 	return &s
 }
 
-func (s *Server) getEnforcer(handle int) (*casbin.Enforcer, error) {
+func (s *Server) getEnforcer(handle int) (*casbin.SyncedEnforcer, error) {
 	s.muE.RLock()
 	defer s.muE.RUnlock()
 
 	if e, ok := s.enforcerMap[handle]; ok {
 		return e, nil
 	} else {
-		return nil, errors.New("enforcer not found")
+		return nil, errEnforcerNotFound
 	}
 }
 
@@ -62,31 +96,135 @@ func (s *Server) getAdapter(handle int) (persist.Adapter, error) {
 	if a, ok := s.adapterMap[handle]; ok {
 		return a, nil
 	} else {
-		return nil, errors.New("adapter not found")
+		return nil, errAdapterNotFound
 	}
 }
 
-func (s *Server) addEnforcer(e *casbin.Enforcer) int {
+// getCache returns the decision cache of the enforcer behind handle, or nil if it has none.
+func (s *Server) getCache(handle int) *decisionCache {
+	s.muE.RLock()
+	defer s.muE.RUnlock()
+
+	return s.cacheMap[handle]
+}
+
+// getWatcher returns the watcher of the enforcer behind handle, or nil if it has none.
+func (s *Server) getWatcher(handle int) persist.Watcher {
+	s.muE.RLock()
+	defer s.muE.RUnlock()
+
+	return s.watcherMap[handle]
+}
+
+// addEnforcer registers e with its decision cache c, which may be nil.
+func (s *Server) addEnforcer(e *casbin.SyncedEnforcer, c *decisionCache) int {
 	s.muE.Lock()
 	defer s.muE.Unlock()
 
-	cnt := len(s.enforcerMap)
-	s.enforcerMap[cnt] = e
-	return cnt
+	handle := s.nextEnforcerHandle
+	s.nextEnforcerHandle++
+	s.enforcerMap[handle] = e
+	if c != nil {
+		s.cacheMap[handle] = c
+	}
+	return handle
+}
+
+// addNamedEnforcer registers e, its watcher w and its decision cache c, which may be nil, under name.
+// If the name is already taken, the handle of the existing enforcer is returned
+// with ok set to false and e is not registered.
+func (s *Server) addNamedEnforcer(name string, e *casbin.SyncedEnforcer, w persist.Watcher, c *decisionCache) (handle int, ok bool) {
+	s.muE.Lock()
+	defer s.muE.Unlock()
+
+	if h, found := s.nameMap[name]; found {
+		return h, false
+	}
+
+	handle = s.nextEnforcerHandle
+	s.nextEnforcerHandle++
+	s.enforcerMap[handle] = e
+	s.nameMap[name] = handle
+	if w != nil {
+		s.watcherMap[handle] = w
+	}
+	if c != nil {
+		s.cacheMap[handle] = c
+	}
+	return handle, true
+}
+
+func (s *Server) getEnforcerHandleByName(name string) (int, error) {
+	s.muE.RLock()
+	defer s.muE.RUnlock()
+
+	if h, ok := s.nameMap[name]; ok {
+		return h, nil
+	} else {
+		return 0, errEnforcerNotFound
+	}
 }
 
 func (s *Server) addAdapter(a persist.Adapter) int {
 	s.muA.Lock()
 	defer s.muA.Unlock()
 
-	cnt := len(s.adapterMap)
-	s.adapterMap[cnt] = a
-	return cnt
+	handle := s.nextAdapterHandle
+	s.nextAdapterHandle++
+	s.adapterMap[handle] = a
+	return handle
+}
+
+// removeEnforcer unregisters the enforcer behind handle and returns it with its watcher, if it has one.
+func (s *Server) removeEnforcer(handle int) (*casbin.SyncedEnforcer, persist.Watcher, error) {
+	s.muE.Lock()
+	defer s.muE.Unlock()
+
+	e, ok := s.enforcerMap[handle]
+	if !ok {
+		return nil, nil, errEnforcerNotFound
+	}
+	w := s.watcherMap[handle]
+	delete(s.enforcerMap, handle)
+	delete(s.watcherMap, handle)
+	delete(s.cacheMap, handle)
+	for name, h := range s.nameMap {
+		if h == handle {
+			delete(s.nameMap, name)
+			break
+		}
+	}
+	return e, w, nil
+}
+
+func (s *Server) removeAdapter(handle int) (persist.Adapter, error) {
+	s.muA.Lock()
+	defer s.muA.Unlock()
+
+	a, ok := s.adapterMap[handle]
+	if !ok {
+		return nil, errAdapterNotFound
+	}
+	delete(s.adapterMap, handle)
+	return a, nil
 }
 
+// NewEnforcer creates an enforcer and returns its handle. If a name is given, other
+// clients can look the enforcer up with GetEnforcerByName. With getOrCreate set,
+// the handle of an existing enforcer with the same name is returned instead of
+// creating a new one.
 func (s *Server) NewEnforcer(ctx context.Context, in *pb.NewEnforcerRequest) (*pb.NewEnforcerReply, error) {
 	var a persist.Adapter
-	var e *casbin.Enforcer
+
+	if in.Name != "" {
+		h, err := s.getEnforcerHandleByName(in.Name)
+		if err == nil {
+			if !in.GetOrCreate {
+				return &pb.NewEnforcerReply{Handler: 0}, errEnforcerNameExists
+			}
+			return &pb.NewEnforcerReply{Handler: int32(h)}, nil
+		}
+	}
 
 	if in.AdapterHandle != -1 {
 		var err error
@@ -96,11 +234,43 @@ func (s *Server) NewEnforcer(ctx context.Context, in *pb.NewEnforcerRequest) (*p
 		}
 	}
 
+	e, w, c, err := s.newEnforcer(ctx, in, a, defaultDecisionCacheSize)
+	if err != nil {
+		return &pb.NewEnforcerReply{Handler: 0}, err
+	}
+
+	if in.Name == "" {
+		h := s.addEnforcer(e, c)
+		return &pb.NewEnforcerReply{Handler: int32(h)}, nil
+	}
+
+	// Another client may have registered the name while the model and policy were loading.
+	h, ok := s.addNamedEnforcer(in.Name, e, w, c)
+	if !ok {
+		if w != nil {
+			w.Close()
+		}
+		if !in.GetOrCreate {
+			return &pb.NewEnforcerReply{Handler: 0}, errEnforcerNameExists
+		}
+	}
+
+	return &pb.NewEnforcerReply{Handler: int32(h)}, nil
+}
+
+// newEnforcer creates the enforcer requested by in, loading its policy from a if it is not nil,
+// with its watcher and decision cache of cacheSize decisions, which are nil unless requested.
+func (s *Server) newEnforcer(ctx context.Context, in *pb.NewEnforcerRequest, a persist.Adapter, cacheSize int) (*casbin.SyncedEnforcer, persist.Watcher, *decisionCache, error) {
+	var e *casbin.SyncedEnforcer
+
 	if in.ModelText == "" {
-		cfg := LoadConfiguration(getLocalConfigPath())
+		cfg, err := loadLocalConfig()
+		if err != nil {
+			return nil, nil, nil, err
+		}
 		data, err := os.ReadFile(cfg.Enforcer)
 		if err != nil {
-			return &pb.NewEnforcerReply{Handler: 0}, err
+			return nil, nil, nil, wrapError(codes.FailedPrecondition, ReasonConfigUnavailable, err)
 		}
 		in.ModelText = string(data)
 	}
@@ -108,36 +278,84 @@ func (s *Server) NewEnforcer(ctx context.Context, in *pb.NewEnforcerRequest) (*p
 	if a == nil {
 		m, err := model.NewModelFromString(in.ModelText)
 		if err != nil {
-			return &pb.NewEnforcerReply{Handler: 0}, err
+			return nil, nil, nil, modelError(err)
 		}
 
-		e, err = casbin.NewEnforcer(m, false)
+		e, err = casbin.NewSyncedEnforcer(m, false)
 		if err != nil {
-			return &pb.NewEnforcerReply{Handler: 0}, err
+			return nil, nil, nil, modelError(err)
 		}
 	} else {
 		m, err := model.NewModelFromString(in.ModelText)
 		if err != nil {
-			return &pb.NewEnforcerReply{Handler: 0}, err
+			return nil, nil, nil, modelError(err)
 		}
 
-		e, err = casbin.NewEnforcer(m, a)
+		err = s.adapterCall(ctx, "load", func() error {
+			var err error
+			e, err = casbin.NewSyncedEnforcer(m, a)
+			return err
+		})
 		if err != nil {
-			return &pb.NewEnforcerReply{Handler: 0}, err
+			return nil, nil, nil, adapterError(err)
 		}
 	}
 
 	e.EnableAcceptJsonRequest(in.EnableAcceptJsonRequest)
 
-	h := s.addEnforcer(e)
+	w, err := s.newEnforcerWatcher(in, e)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	var c *decisionCache
+	if in.EnableCache {
+		c = newDecisionCache(cacheSize)
+	}
+
+	return e, w, c, nil
+}
+
+// GetEnforcerByName gets the handle of the enforcer created with the given name.
+func (s *Server) GetEnforcerByName(ctx context.Context, in *pb.EnforcerNameRequest) (*pb.NewEnforcerReply, error) {
+	h, err := s.getEnforcerHandleByName(in.Name)
+	if err != nil {
+		return &pb.NewEnforcerReply{Handler: 0}, err
+	}
 
 	return &pb.NewEnforcerReply{Handler: int32(h)}, nil
 }
 
+// ListEnforcers lists the handles of all live enforcers together with their names, ordered by handle.
+func (s *Server) ListEnforcers(ctx context.Context, in *pb.EmptyRequest) (*pb.EnforcerListReply, error) {
+	s.muE.RLock()
+	defer s.muE.RUnlock()
+
+	names := make(map[int]string, len(s.nameMap))
+	for name, h := range s.nameMap {
+		names[h] = name
+	}
+
+	reply := &pb.EnforcerListReply{Enforcers: make([]*pb.EnforcerListReplyEnforcer, 0, len(s.enforcerMap))}
+	for h := range s.enforcerMap {
+		reply.Enforcers = append(reply.Enforcers, &pb.EnforcerListReplyEnforcer{Handler: int32(h), Name: names[h]})
+	}
+	sort.Slice(reply.Enforcers, func(i, j int) bool {
+		return reply.Enforcers[i].Handler < reply.Enforcers[j].Handler
+	})
+
+	return reply, nil
+}
+
 func (s *Server) NewAdapter(ctx context.Context, in *pb.NewAdapterRequest) (*pb.NewAdapterReply, error) {
-	a, err := newAdapter(in)
+	var a persist.Adapter
+	err := s.adapterCall(ctx, "connect", func() error {
+		var err error
+		a, err = newAdapter(in)
+		return err
+	})
 	if err != nil {
-		return nil, err
+		return nil, adapterError(err)
 	}
 
 	h := s.addAdapter(a)
@@ -145,55 +363,210 @@ func (s *Server) NewAdapter(ctx context.Context, in *pb.NewAdapterRequest) (*pb.
 	return &pb.NewAdapterReply{Handler: int32(h)}, nil
 }
 
-func (s *Server) parseParam(param, matcher string) (interface{}, string) {
+// FreeEnforcer releases the enforcer behind the handle and closes its watcher. The handle is never reused.
+func (s *Server) FreeEnforcer(ctx context.Context, in *pb.EmptyRequest) (*pb.EmptyReply, error) {
+	_, w, err := s.removeEnforcer(int(in.Handler))
+	if err != nil {
+		return &pb.EmptyReply{}, err
+	}
+	if w != nil {
+		w.Close()
+	}
+
+	s.closeWatchers(int(in.Handler))
+	s.metrics.forgetEnforcer(int(in.Handler))
+	return &pb.EmptyReply{}, nil
+}
+
+// FreeAdapter closes the adapter behind the handle and releases it. The handle is never reused.
+func (s *Server) FreeAdapter(ctx context.Context, in *pb.EmptyRequest) (*pb.EmptyReply, error) {
+	a, err := s.removeAdapter(int(in.Handler))
+	if err != nil {
+		return &pb.EmptyReply{}, err
+	}
+
+	err = s.adapterCall(ctx, "close", func() error {
+		return closeAdapter(a)
+	})
+	return &pb.EmptyReply{}, adapterError(err)
+}
+
+func (s *Server) parseParam(ctx context.Context, param string) (interface{}, error) {
 	if strings.HasPrefix(param, "ABAC::") {
+		_, span := tracer.Start(ctx, "ResolveABAC")
 		attrList, err := resolveABAC(param)
+		endSpan(span, err)
 		if err != nil {
-			panic(err)
+			return nil, invalidArgumentError(err)
 		}
-		for k, v := range attrList.nameMap {
-			old := "." + k
-			if strings.Contains(matcher, old) {
-				matcher = strings.Replace(matcher, old, "."+v, -1)
-			}
-		}
-		return attrList, matcher
+		return attrList, nil
 	} else {
-		return param, matcher
+		return param, nil
 	}
 }
 
+// parseParams converts the request params, resolving any ABAC params into their attributes.
+// Typed values take precedence over the string params and are passed on as plain Go values,
+// like JSON requests are when EnableAcceptJsonRequest is set.
+func (s *Server) parseParams(ctx context.Context, in []string, values []*structpb.Value) ([]interface{}, error) {
+	if len(values) > 0 {
+		params := make([]interface{}, 0, len(values))
+		for _, v := range values {
+			params = append(params, v.AsInterface())
+		}
+		return params, nil
+	}
+
+	params := make([]interface{}, 0, len(in))
+
+	for index := range in {
+		param, err := s.parseParam(ctx, in[index])
+		if err != nil {
+			return nil, err
+		}
+		params = append(params, param)
+	}
+
+	return params, nil
+}
+
+// enforce decides a request with e, the enforcer behind handle, in a span, and records
+// the decision in the metrics and the audit log.
+func (s *Server) enforce(ctx context.Context, handle int, e *casbin.SyncedEnforcer, params ...interface{}) (bool, error) {
+	res, _, err := s.enforceEx(ctx, "casbin.Enforce", handle, e, params)
+	return res, err
+}
+
+// enforceEx is enforce, also returning the policy rule that produced the decision.
+// The decision is looked up in the cache of the enforcer first, if it has one.
+func (s *Server) enforceEx(ctx context.Context, spanName string, handle int, e *casbin.SyncedEnforcer, params []interface{}) (bool, []string, error) {
+	_, span := tracer.Start(ctx, spanName, trace.WithAttributes(attribute.Int("casbin.enforcer", handle)))
+	start := time.Now()
+	res, explain, err := s.cachedEnforceEx(handle, e, params)
+	s.metrics.observeEnforce(handle, res, err, time.Since(start))
+	span.SetAttributes(attribute.Bool("casbin.result", res))
+	endSpan(span, err)
+	s.audit.recordDecision(ctx, handle, params, res, explain, err)
+	return res, explain, err
+}
+
+// cachedEnforceEx decides a request with e, using the decision cache of handle if it has one.
+// Errors are not cached.
+func (s *Server) cachedEnforceEx(handle int, e *casbin.SyncedEnforcer, params []interface{}) (bool, []string, error) {
+	c := s.getCache(handle)
+	if c == nil {
+		return e.EnforceEx(params...)
+	}
+	key, ok := cacheKey(params)
+	if !ok {
+		return e.EnforceEx(params...)
+	}
+
+	d, generation, ok := c.get(key)
+	if ok {
+		return d.res, d.explain, nil
+	}
+	res, explain, err := e.EnforceEx(params...)
+	if err == nil {
+		c.set(key, generation, cachedDecision{res: res, explain: explain})
+	}
+	return res, explain, err
+}
+
 func (s *Server) Enforce(ctx context.Context, in *pb.EnforceRequest) (*pb.BoolReply, error) {
 	e, err := s.getEnforcer(int(in.EnforcerHandler))
 	if err != nil {
 		return &pb.BoolReply{Res: false}, err
 	}
-	var param interface{}
-	params := make([]interface{}, 0, len(in.Params))
-	m := e.GetModel()["m"]["m"].Value
 
-	for index := range in.Params {
-		param, m = s.parseParam(in.Params[index], m)
-		params = append(params, param)
+	params, err := s.parseParams(ctx, in.Params, in.Values)
+	if err != nil {
+		return &pb.BoolReply{Res: false}, err
 	}
 
-	res, err := e.EnforceWithMatcher(m, params...)
+	res, err := s.enforce(ctx, int(in.EnforcerHandler), e, params...)
 	if err != nil {
-		return &pb.BoolReply{Res: false}, err
+		return &pb.BoolReply{Res: false}, invalidArgumentError(err)
 	}
 
 	return &pb.BoolReply{Res: res}, nil
 }
 
+// EnforceEx decides whether a request is allowed like Enforce and also returns the
+// policy rule that produced the decision. The explanation is empty when no rule matched.
+func (s *Server) EnforceEx(ctx context.Context, in *pb.EnforceRequest) (*pb.EnforceExReply, error) {
+	e, err := s.getEnforcer(int(in.EnforcerHandler))
+	if err != nil {
+		return &pb.EnforceExReply{Res: false}, err
+	}
+
+	params, err := s.parseParams(ctx, in.Params, in.Values)
+	if err != nil {
+		return &pb.EnforceExReply{Res: false}, err
+	}
+
+	res, explain, err := s.enforceEx(ctx, "casbin.EnforceEx", int(in.EnforcerHandler), e, params)
+	if err != nil {
+		return &pb.EnforceExReply{Res: false}, invalidArgumentError(err)
+	}
+
+	return &pb.EnforceExReply{Res: res, Explain: explain}, nil
+}
+
+// BatchEnforce enforces each request in the batch and returns the decisions in request order.
+// The requests are evaluated concurrently. If any of them fails, the first error is returned.
+func (s *Server) BatchEnforce(ctx context.Context, in *pb.BatchEnforceRequest) (*pb.BatchBoolReply, error) {
+	e, err := s.getEnforcer(int(in.EnforcerHandler))
+	if err != nil {
+		return &pb.BatchBoolReply{}, err
+	}
+
+	res := make([]bool, len(in.Requests))
+	errs := make([]error, len(in.Requests))
+	sem := make(chan struct{}, runtime.GOMAXPROCS(0))
+	var wg sync.WaitGroup
+
+	for i := range in.Requests {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			params, err := s.parseParams(ctx, in.Requests[i].Params, in.Requests[i].Values)
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			res[i], errs[i] = s.enforce(ctx, int(in.EnforcerHandler), e, params...)
+		}(i)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return &pb.BatchBoolReply{}, invalidArgumentError(err)
+		}
+	}
+
+	return &pb.BatchBoolReply{Res: res}, nil
+}
+
 func (s *Server) LoadPolicy(ctx context.Context, in *pb.EmptyRequest) (*pb.EmptyReply, error) {
 	e, err := s.getEnforcer(int(in.Handler))
 	if err != nil {
 		return &pb.EmptyReply{}, err
 	}
 
-	err = e.LoadPolicy()
+	err = s.adapterCall(ctx, "load", e.LoadPolicy)
+	if err != nil {
+		return &pb.EmptyReply{}, adapterError(err)
+	}
 
-	return &pb.EmptyReply{}, err
+	s.publishPolicyEvent(int(in.Handler), &pb.PolicyEvent{EventType: pb.PolicyEvent_LOAD})
+	return &pb.EmptyReply{}, nil
 }
 
 func (s *Server) SavePolicy(ctx context.Context, in *pb.EmptyRequest) (*pb.EmptyReply, error) {
@@ -202,7 +575,11 @@ func (s *Server) SavePolicy(ctx context.Context, in *pb.EmptyRequest) (*pb.Empty
 		return &pb.EmptyReply{}, err
 	}
 
-	err = e.SavePolicy()
+	err = s.adapterCall(ctx, "save", e.SavePolicy)
+	if err != nil {
+		return &pb.EmptyReply{}, adapterError(err)
+	}
 
-	return &pb.EmptyReply{}, err
+	s.publishPolicyEvent(int(in.Handler), &pb.PolicyEvent{EventType: pb.PolicyEvent_SAVE})
+	return &pb.EmptyReply{}, nil
 }
@@ -0,0 +1,148 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	pb "github.com/casbin/casbin-server/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestFreeEnforcer(t *testing.T) {
+	e := newTestEngine(t, "file", "../examples/rbac_policy.csv", "../examples/rbac_model.conf")
+
+	_, err := e.s.FreeEnforcer(e.ctx, &pb.EmptyRequest{Handler: e.h})
+	assert.NoError(t, err)
+
+	_, err = e.s.Enforce(e.ctx, &pb.EnforceRequest{EnforcerHandler: e.h, Params: []string{"alice", "data1", "read"}})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = e.s.FreeEnforcer(e.ctx, &pb.EmptyRequest{Handler: e.h})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// A freed handle must never be handed out again.
+	resp, err := e.s.NewEnforcer(e.ctx, &pb.NewEnforcerRequest{ModelText: e.modelText, AdapterHandle: -1})
+	assert.NoError(t, err)
+	assert.NotEqual(t, e.h, resp.Handler)
+}
+
+func TestFreeAdapter(t *testing.T) {
+	e := newTestEngine(t, "file", "../examples/rbac_policy.csv", "../examples/rbac_model.conf")
+
+	_, err := e.s.FreeAdapter(e.ctx, &pb.EmptyRequest{Handler: 0})
+	assert.NoError(t, err)
+
+	_, err = e.s.NewEnforcer(e.ctx, &pb.NewEnforcerRequest{ModelText: e.modelText, AdapterHandle: 0})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	resp, err := e.s.NewAdapter(e.ctx, &pb.NewAdapterRequest{DriverName: "file", ConnectString: "../examples/rbac_policy.csv"})
+	assert.NoError(t, err)
+	assert.NotEqual(t, int32(0), resp.Handler)
+}
+
+func TestNamedEnforcer(t *testing.T) {
+	e := newTestEngine(t, "file", "../examples/rbac_policy.csv", "../examples/rbac_model.conf")
+
+	resp, err := e.s.NewEnforcer(e.ctx, &pb.NewEnforcerRequest{ModelText: e.modelText, AdapterHandle: 0, Name: "billing"})
+	assert.NoError(t, err)
+	billing := resp.Handler
+
+	_, err = e.s.NewEnforcer(e.ctx, &pb.NewEnforcerRequest{ModelText: e.modelText, AdapterHandle: 0, Name: "billing"})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	resp, err = e.s.NewEnforcer(e.ctx, &pb.NewEnforcerRequest{ModelText: e.modelText, AdapterHandle: 0, Name: "billing", GetOrCreate: true})
+	assert.NoError(t, err)
+	assert.Equal(t, billing, resp.Handler)
+
+	resp, err = e.s.GetEnforcerByName(e.ctx, &pb.EnforcerNameRequest{Name: "billing"})
+	assert.NoError(t, err)
+	assert.Equal(t, billing, resp.Handler)
+
+	_, err = e.s.GetEnforcerByName(e.ctx, &pb.EnforcerNameRequest{Name: "shipping"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	list, err := e.s.ListEnforcers(e.ctx, &pb.EmptyRequest{})
+	assert.NoError(t, err)
+	assert.Len(t, list.Enforcers, 2)
+	assert.Equal(t, e.h, list.Enforcers[0].Handler)
+	assert.Equal(t, "", list.Enforcers[0].Name)
+	assert.Equal(t, billing, list.Enforcers[1].Handler)
+	assert.Equal(t, "billing", list.Enforcers[1].Name)
+
+	_, err = e.s.FreeEnforcer(e.ctx, &pb.EmptyRequest{Handler: billing})
+	assert.NoError(t, err)
+
+	_, err = e.s.GetEnforcerByName(e.ctx, &pb.EnforcerNameRequest{Name: "billing"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestErrorCodes(t *testing.T) {
+	e := newTestEngine(t, "file", "../examples/rbac_policy.csv", "../examples/rbac_model.conf")
+
+	_, err := e.s.Enforce(e.ctx, &pb.EnforceRequest{EnforcerHandler: e.h + 1})
+	assertErrorReason(t, err, codes.NotFound, ReasonEnforcerNotFound)
+
+	_, err = e.s.NewEnforcer(e.ctx, &pb.NewEnforcerRequest{ModelText: "[request_definition]", AdapterHandle: -1})
+	assertErrorReason(t, err, codes.InvalidArgument, ReasonInvalidModel)
+
+	_, err = e.s.NewAdapter(e.ctx, &pb.NewAdapterRequest{DriverName: "oracle", ConnectString: "localhost"})
+	assertErrorReason(t, err, codes.InvalidArgument, ReasonInvalidDriver)
+
+	_, err = e.s.GetFilteredPolicy(e.ctx, &pb.FilteredPolicyRequest{EnforcerHandler: e.h, FieldIndex: 2, FieldValues: []string{"data1", "read"}})
+	assertErrorReason(t, err, codes.InvalidArgument, ReasonInvalidFieldIndex)
+
+	_, err = e.s.RemoveFilteredPolicy(e.ctx, &pb.FilteredPolicyRequest{EnforcerHandler: e.h, FieldIndex: -1, FieldValues: []string{"alice"}})
+	assertErrorReason(t, err, codes.InvalidArgument, ReasonInvalidFieldIndex)
+
+	_, err = e.s.AddNamedPolicy(e.ctx, &pb.PolicyRequest{EnforcerHandler: e.h, PType: "p2", Params: []string{"alice", "data1", "read"}})
+	assertErrorReason(t, err, codes.InvalidArgument, ReasonInvalidArgument)
+
+	_, err = e.s.Enforce(e.ctx, &pb.EnforceRequest{EnforcerHandler: e.h, Params: []string{"alice"}})
+	assertErrorReason(t, err, codes.InvalidArgument, ReasonInvalidArgument)
+}
+
+func TestRoleManagerNil(t *testing.T) {
+	s := NewServer()
+
+	modelText, err := os.ReadFile("../examples/basic_without_resources_model.conf")
+	assert.NoError(t, err)
+
+	resp, err := s.NewEnforcer(context.Background(), &pb.NewEnforcerRequest{ModelText: string(modelText), AdapterHandle: -1})
+	assert.NoError(t, err)
+
+	_, err = s.GetRolesForUser(context.Background(), &pb.UserRoleRequest{EnforcerHandler: resp.Handler, User: "alice"})
+	assertErrorReason(t, err, codes.FailedPrecondition, ReasonRoleManagerNil)
+}
+
+func assertErrorReason(t *testing.T, err error, code codes.Code, reason string) {
+	t.Helper()
+	st := status.Convert(err)
+	assert.Equal(t, code, st.Code(), st.Message())
+
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok {
+			assert.Equal(t, ErrorDomain, info.Domain)
+			assert.Equal(t, reason, info.Reason)
+			return
+		}
+	}
+	t.Errorf("%v: no ErrorInfo detail", err)
+}
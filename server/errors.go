@@ -0,0 +1,125 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/casbin/casbin/v2"
+	casbinerrors "github.com/casbin/casbin/v2/errors"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrorDomain is the domain of the ErrorInfo detail attached to every error returned by the server.
+const ErrorDomain = "casbin-server"
+
+// Reasons of the ErrorInfo detail attached to errors returned by the server, so clients can branch on the failure type.
+const (
+	ReasonEnforcerNotFound   = "ENFORCER_NOT_FOUND"
+	ReasonAdapterNotFound    = "ADAPTER_NOT_FOUND"
+	ReasonEnforcerNameExists = "ENFORCER_NAME_EXISTS"
+	ReasonInvalidModel       = "INVALID_MODEL"
+	ReasonInvalidArgument    = "INVALID_ARGUMENT"
+	ReasonInvalidFieldIndex  = "INVALID_FIELD_INDEX"
+	ReasonInvalidDriver      = "INVALID_DRIVER"
+	ReasonRoleManagerNil     = "ROLE_MANAGER_NIL"
+	ReasonConfigUnavailable  = "CONFIG_UNAVAILABLE"
+	ReasonAdapterUnavailable = "ADAPTER_UNAVAILABLE"
+	ReasonWatcherUnavailable = "WATCHER_UNAVAILABLE"
+	ReasonUnauthenticated    = "UNAUTHENTICATED"
+	ReasonPermissionDenied   = "PERMISSION_DENIED"
+	ReasonInternal           = "INTERNAL"
+)
+
+var (
+	errEnforcerNotFound   = newError(codes.NotFound, ReasonEnforcerNotFound, "enforcer not found")
+	errAdapterNotFound    = newError(codes.NotFound, ReasonAdapterNotFound, "adapter not found")
+	errEnforcerNameExists = newError(codes.AlreadyExists, ReasonEnforcerNameExists, "enforcer name already exists")
+	errRoleManagerNil     = newError(codes.FailedPrecondition, ReasonRoleManagerNil, "RoleManager is nil")
+)
+
+// newError creates a gRPC status error carrying an ErrorInfo detail with the given reason.
+func newError(c codes.Code, reason string, msg string) error {
+	// The connection strings in the messages of the adapters may contain secrets.
+	st := status.New(c, redact(msg))
+	if detailed, err := st.WithDetails(&errdetails.ErrorInfo{Reason: reason, Domain: ErrorDomain}); err == nil {
+		st = detailed
+	}
+	return st.Err()
+}
+
+// wrapError converts err into a gRPC status error. Errors that already carry a status are returned unchanged.
+func wrapError(c codes.Code, reason string, err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	return newError(c, reason, err.Error())
+}
+
+func invalidArgumentError(err error) error {
+	return wrapError(codes.InvalidArgument, ReasonInvalidArgument, err)
+}
+
+func modelError(err error) error {
+	return wrapError(codes.InvalidArgument, ReasonInvalidModel, err)
+}
+
+func adapterError(err error) error {
+	return wrapError(codes.Unavailable, ReasonAdapterUnavailable, err)
+}
+
+// policyError converts an error returned while reading or changing the policy of e.
+// A ptype that is not defined by the model is a bad argument, anything else is a
+// failure of the adapter that stores the policy.
+func policyError(e *casbin.SyncedEnforcer, sec string, ptype string, err error) error {
+	if err == nil {
+		return nil
+	}
+	if errors.Is(err, casbinerrors.ErrInvalidFieldValuesParameter) {
+		return invalidArgumentError(err)
+	}
+	e.GetLock().RLock()
+	_, assertionErr := e.GetModel().GetAssertion(sec, ptype)
+	e.GetLock().RUnlock()
+	if assertionErr != nil {
+		return invalidArgumentError(err)
+	}
+	return adapterError(err)
+}
+
+// checkFieldIndex makes sure that count fields starting at fieldIndex exist in the
+// rules of the named policy, as casbin indexes the rules without checking.
+func checkFieldIndex(e *casbin.SyncedEnforcer, sec string, ptype string, fieldIndex int, count int) error {
+	e.GetLock().RLock()
+	assertion, err := e.GetModel().GetAssertion(sec, ptype)
+	e.GetLock().RUnlock()
+	if err != nil {
+		return invalidArgumentError(err)
+	}
+	if count < 1 {
+		count = 1
+	}
+	if fieldIndex < 0 || fieldIndex+count > len(assertion.Tokens) {
+		return newError(codes.InvalidArgument, ReasonInvalidFieldIndex,
+			fmt.Sprintf("invalid field index %d for %d field(s) of %s", fieldIndex, count, ptype))
+	}
+	return nil
+}
@@ -0,0 +1,186 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	authv3 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
+	typev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	rpcstatus "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc/codes"
+)
+
+// ExtAuthzConfig maps the Envoy CheckRequest to the params of an enforcer.
+type ExtAuthzConfig struct {
+	// Enforcer is the name of the enforcer that checks the requests. It is looked up on
+	// every check, so it can be created with NewEnforcer after the server has started.
+	Enforcer string `json:"enforcer"`
+	// Params are the attributes of the request passed to Enforce, in order:
+	//
+	//   source.principal, destination.principal, source.address
+	//   path (without the query string), method, host, scheme
+	//   header:<name>, context:<key> (context extensions of the route), literal:<value>
+	//
+	// The default is source.principal, path, method.
+	Params []string `json:"params"`
+	// OkHeaders are added to the allowed requests sent upstream.
+	OkHeaders map[string]string `json:"okHeaders"`
+	// DeniedHeaders are added to the response of the denied requests.
+	DeniedHeaders map[string]string `json:"deniedHeaders"`
+}
+
+var defaultExtAuthzParams = []string{"source.principal", "path", "method"}
+
+// LoadExtAuthzConfig reads an ExtAuthzConfig from a JSON file.
+func LoadExtAuthzConfig(path string) (*ExtAuthzConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	config := &ExtAuthzConfig{}
+	if err := json.Unmarshal(data, config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// ExtAuthz implements the Envoy external authorization service envoy.service.auth.v3.Authorization
+// with an enforcer of the server.
+type ExtAuthz struct {
+	s      *Server
+	config ExtAuthzConfig
+}
+
+// NewExtAuthz creates the external authorization service of s.
+func NewExtAuthz(s *Server, config *ExtAuthzConfig) (*ExtAuthz, error) {
+	if config.Enforcer == "" {
+		return nil, errors.New("the name of the enforcer is required")
+	}
+
+	a := &ExtAuthz{s: s, config: *config}
+	if len(a.config.Params) == 0 {
+		a.config.Params = defaultExtAuthzParams
+	}
+	for _, param := range a.config.Params {
+		if _, err := extAuthzParam(&authv3.CheckRequest{}, param); err != nil {
+			return nil, err
+		}
+	}
+	return a, nil
+}
+
+func extAuthzParam(req *authv3.CheckRequest, param string) (string, error) {
+	attrs := req.GetAttributes()
+	http := attrs.GetRequest().GetHttp()
+
+	switch param {
+	case "source.principal":
+		return attrs.GetSource().GetPrincipal(), nil
+	case "destination.principal":
+		return attrs.GetDestination().GetPrincipal(), nil
+	case "source.address":
+		return attrs.GetSource().GetAddress().GetSocketAddress().GetAddress(), nil
+	case "path":
+		path := http.GetPath()
+		if i := strings.IndexByte(path, '?'); i >= 0 {
+			path = path[:i]
+		}
+		return path, nil
+	case "method":
+		return http.GetMethod(), nil
+	case "host":
+		return http.GetHost(), nil
+	case "scheme":
+		return http.GetScheme(), nil
+	}
+
+	switch {
+	case strings.HasPrefix(param, "header:"):
+		// Envoy sends the header names in lowercase.
+		return http.GetHeaders()[strings.ToLower(strings.TrimPrefix(param, "header:"))], nil
+	case strings.HasPrefix(param, "context:"):
+		return attrs.GetContextExtensions()[strings.TrimPrefix(param, "context:")], nil
+	case strings.HasPrefix(param, "literal:"):
+		return strings.TrimPrefix(param, "literal:"), nil
+	}
+	return "", fmt.Errorf("unknown ext_authz param %q", param)
+}
+
+func headerOptions(headers map[string]string) []*corev3.HeaderValueOption {
+	keys := make([]string, 0, len(headers))
+	for k := range headers {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	options := make([]*corev3.HeaderValueOption, 0, len(keys))
+	for _, k := range keys {
+		options = append(options, &corev3.HeaderValueOption{Header: &corev3.HeaderValue{Key: k, Value: headers[k]}})
+	}
+	return options
+}
+
+// Check decides whether the request described by in is allowed. Errors, like a missing
+// enforcer, are returned as gRPC errors so that Envoy applies its failure_mode_allow setting.
+func (a *ExtAuthz) Check(ctx context.Context, in *authv3.CheckRequest) (*authv3.CheckResponse, error) {
+	h, err := a.s.getEnforcerHandleByName(a.config.Enforcer)
+	if err != nil {
+		return nil, err
+	}
+	e, err := a.s.getEnforcer(h)
+	if err != nil {
+		return nil, err
+	}
+
+	params := make([]interface{}, 0, len(a.config.Params))
+	for _, param := range a.config.Params {
+		value, err := extAuthzParam(in, param)
+		if err != nil {
+			return nil, invalidArgumentError(err)
+		}
+		params = append(params, value)
+	}
+
+	res, err := a.s.enforce(ctx, h, e, params...)
+	if err != nil {
+		return nil, invalidArgumentError(err)
+	}
+
+	if !res {
+		return &authv3.CheckResponse{
+			Status: &rpcstatus.Status{Code: int32(codes.PermissionDenied), Message: "permission denied"},
+			HttpResponse: &authv3.CheckResponse_DeniedResponse{DeniedResponse: &authv3.DeniedHttpResponse{
+				Status:  &typev3.HttpStatus{Code: typev3.StatusCode_Forbidden},
+				Headers: headerOptions(a.config.DeniedHeaders),
+				Body:    "permission denied",
+			}},
+		}, nil
+	}
+
+	return &authv3.CheckResponse{
+		Status: &rpcstatus.Status{Code: int32(codes.OK)},
+		HttpResponse: &authv3.CheckResponse_OkResponse{OkResponse: &authv3.OkHttpResponse{
+			Headers: headerOptions(a.config.OkHeaders),
+		}},
+	}, nil
+}
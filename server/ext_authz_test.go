@@ -0,0 +1,97 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"testing"
+
+	pb "github.com/casbin/casbin-server/proto"
+	authv3 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
+	typev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func newCheckRequest(principal, method, path string, headers map[string]string) *authv3.CheckRequest {
+	return &authv3.CheckRequest{Attributes: &authv3.AttributeContext{
+		Source: &authv3.AttributeContext_Peer{Principal: principal},
+		Request: &authv3.AttributeContext_Request{Http: &authv3.AttributeContext_HttpRequest{
+			Method:  method,
+			Path:    path,
+			Host:    "orders.example",
+			Headers: headers,
+		}},
+		ContextExtensions: map[string]string{"service": "orders"},
+	}}
+}
+
+func TestExtAuthz(t *testing.T) {
+	e := newTestEngine(t, "file", "../examples/rbac_policy.csv", "../examples/rbac_model.conf")
+
+	a, err := NewExtAuthz(e.s, &ExtAuthzConfig{
+		Enforcer:      "envoy",
+		OkHeaders:     map[string]string{"x-casbin-allowed": "true"},
+		DeniedHeaders: map[string]string{"x-casbin-allowed": "false"},
+	})
+	assert.NoError(t, err)
+
+	// The enforcer is created through NewEnforcer after the service.
+	_, err = a.Check(e.ctx, newCheckRequest("spiffe://cluster/alice", "GET", "/orders", nil))
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	resp, err := e.s.NewEnforcer(e.ctx, &pb.NewEnforcerRequest{ModelText: e.modelText, AdapterHandle: 0, Name: "envoy"})
+	assert.NoError(t, err)
+	_, err = e.s.AddPolicy(e.ctx, &pb.PolicyRequest{EnforcerHandler: resp.Handler, Params: []string{"spiffe://cluster/alice", "/orders", "GET"}})
+	assert.NoError(t, err)
+
+	res, err := a.Check(e.ctx, newCheckRequest("spiffe://cluster/alice", "GET", "/orders?page=2", nil))
+	assert.NoError(t, err)
+	assert.Equal(t, int32(codes.OK), res.Status.Code)
+	assert.Equal(t, "x-casbin-allowed", res.GetOkResponse().Headers[0].Header.Key)
+	assert.Equal(t, "true", res.GetOkResponse().Headers[0].Header.Value)
+
+	res, err = a.Check(e.ctx, newCheckRequest("spiffe://cluster/alice", "DELETE", "/orders", nil))
+	assert.NoError(t, err)
+	assert.Equal(t, int32(codes.PermissionDenied), res.Status.Code)
+	assert.Equal(t, typev3.StatusCode_Forbidden, res.GetDeniedResponse().Status.Code)
+	assert.Equal(t, "false", res.GetDeniedResponse().Headers[0].Header.Value)
+}
+
+func TestExtAuthzMapping(t *testing.T) {
+	e := newTestEngine(t, "file", "../examples/rbac_policy.csv", "../examples/rbac_model.conf")
+	resp, err := e.s.NewEnforcer(e.ctx, &pb.NewEnforcerRequest{ModelText: e.modelText, AdapterHandle: 0, Name: "envoy"})
+	assert.NoError(t, err)
+
+	a, err := NewExtAuthz(e.s, &ExtAuthzConfig{Enforcer: "envoy", Params: []string{"header:X-User", "context:service", "literal:read"}})
+	assert.NoError(t, err)
+
+	_, err = e.s.AddPolicy(e.ctx, &pb.PolicyRequest{EnforcerHandler: resp.Handler, Params: []string{"bob", "orders", "read"}})
+	assert.NoError(t, err)
+
+	res, err := a.Check(e.ctx, newCheckRequest("", "POST", "/anything", map[string]string{"x-user": "bob"}))
+	assert.NoError(t, err)
+	assert.Equal(t, int32(codes.OK), res.Status.Code)
+
+	res, err = a.Check(e.ctx, newCheckRequest("", "POST", "/anything", map[string]string{"x-user": "alice"}))
+	assert.NoError(t, err)
+	assert.Equal(t, int32(codes.PermissionDenied), res.Status.Code)
+
+	_, err = NewExtAuthz(e.s, &ExtAuthzConfig{Enforcer: "envoy", Params: []string{"cookie:session"}})
+	assert.Error(t, err)
+
+	_, err = NewExtAuthz(e.s, &ExtAuthzConfig{})
+	assert.Error(t, err)
+}
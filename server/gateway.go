@@ -0,0 +1,251 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"unicode"
+
+	pb "github.com/casbin/casbin-server/proto"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Gateway serves the Casbin service as HTTP/JSON. Every RPC is reachable as
+//
+//	POST /v1/{rpc}
+//	POST /v1/enforcers/{handle}/{rpc}
+//	POST /v1/adapters/{handle}/{rpc}
+//
+// where {rpc} is the kebab-case method name, e.g. "enforce" or "add-named-policy".
+// The body is the JSON form of the request message. In the second form, the handle in
+// the path is used for the enforcerHandler or handler field of the RPCs acting on an
+// enforcer, and in the third form for the adapter handle of FreeAdapter and NewEnforcer.
+// Other RPCs are not found under these paths.
+// The routes are derived from the unary methods of pb.Casbin_ServiceDesc, so they follow
+// casbin.proto. The streaming WatchPolicy RPC has no route: the gateway only runs the
+// unary interceptor, so it is only served over gRPC, behind the stream interceptors.
+type Gateway struct {
+	srv         pb.CasbinServer
+	interceptor grpc.UnaryServerInterceptor
+	methods     map[string]grpc.MethodDesc
+}
+
+// NewGateway creates a gateway that calls into srv. The interceptor, if not nil,
+// runs around every call like it does for the gRPC server.
+func NewGateway(srv pb.CasbinServer, interceptor grpc.UnaryServerInterceptor) *Gateway {
+	g := &Gateway{srv: srv, interceptor: interceptor, methods: map[string]grpc.MethodDesc{}}
+	for _, m := range pb.Casbin_ServiceDesc.Methods {
+		g.methods[kebabCase(m.MethodName)] = m
+	}
+	return g
+}
+
+func kebabCase(name string) string {
+	var b strings.Builder
+	for i, r := range name {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteByte('-')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+var errGatewayHandle = errors.New("invalid handle in path")
+
+// maxGatewayBodySize is the largest request body accepted, the default limit of the
+// messages received by the gRPC server.
+const maxGatewayBodySize = 4 << 20
+
+func (g *Gateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		writeGatewayError(w, status.Error(codes.Unimplemented, "method not allowed"), http.StatusMethodNotAllowed)
+		return
+	}
+
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	var resource, handle string
+	switch {
+	case len(parts) == 2 && parts[0] == "v1":
+	case len(parts) == 4 && parts[0] == "v1" && (parts[1] == "enforcers" || parts[1] == "adapters"):
+		resource, handle = parts[1], parts[2]
+	default:
+		writeGatewayError(w, status.Error(codes.NotFound, "unknown path "+r.URL.Path), http.StatusNotFound)
+		return
+	}
+
+	m, ok := g.methods[parts[len(parts)-1]]
+	if !ok {
+		writeGatewayError(w, status.Error(codes.NotFound, "unknown path "+r.URL.Path), http.StatusNotFound)
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxGatewayBodySize))
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			writeGatewayError(w, status.Errorf(codes.ResourceExhausted, "request body larger than %d bytes", maxGatewayBodySize), http.StatusRequestEntityTooLarge)
+			return
+		}
+		writeGatewayError(w, invalidArgumentError(err), 0)
+		return
+	}
+
+	dec := func(in interface{}) error {
+		msg := in.(proto.Message)
+		if len(body) > 0 {
+			if err := protojson.Unmarshal(body, msg); err != nil {
+				return invalidArgumentError(err)
+			}
+		}
+		if handle != "" {
+			return setHandle(msg, m.MethodName, resource, handle)
+		}
+		return nil
+	}
+
+	md := metadata.MD{}
+	for k, v := range r.Header {
+		md.Append(k, v...)
+	}
+	ctx := metadata.NewIncomingContext(r.Context(), md)
+	// Expose the caller like the gRPC server does, with its client certificate for mutual TLS authentication.
+	p := &peer.Peer{}
+	if addr, err := net.ResolveTCPAddr("tcp", r.RemoteAddr); err == nil {
+		p.Addr = addr
+	}
+	if r.TLS != nil {
+		p.AuthInfo = credentials.TLSInfo{State: *r.TLS}
+	}
+	ctx = peer.NewContext(ctx, p)
+
+	// Continue the trace of the caller, as the gRPC server does with the otelgrpc stats handler.
+	ctx = otel.GetTextMapPropagator().Extract(ctx, propagation.HeaderCarrier(r.Header))
+	ctx, span := tracer.Start(ctx, pb.Casbin_ServiceDesc.ServiceName+"/"+m.MethodName, trace.WithSpanKind(trace.SpanKindServer))
+
+	resp, err := m.Handler(g.srv, ctx, dec, g.interceptor)
+	endSpan(span, err)
+	if err != nil {
+		writeGatewayError(w, err, 0)
+		return
+	}
+
+	data, err := protojson.MarshalOptions{EmitUnpopulated: true}.Marshal(resp.(proto.Message))
+	if err != nil {
+		writeGatewayError(w, err, 0)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(data)
+}
+
+// adapterHandleFields are the request fields holding an adapter handle, by RPC. In the
+// other RPCs, the enforcerHandler or handler field holds an enforcer handle.
+var adapterHandleFields = map[string]protoreflect.Name{
+	"FreeAdapter": "handler",
+	"NewEnforcer": "adapterHandle",
+}
+
+// setHandle sets the handle of a resource, "enforcers" or "adapters", in the request msg
+// of the RPC method. The path is not found if the RPC does not take such a handle.
+func setHandle(msg proto.Message, method string, resource string, handle string) error {
+	var names []protoreflect.Name
+	if name, ok := adapterHandleFields[method]; ok {
+		if resource == "adapters" {
+			names = []protoreflect.Name{name}
+		}
+	} else if resource == "enforcers" && method != "ListEnforcers" {
+		names = []protoreflect.Name{"enforcerHandler", "handler"}
+	}
+
+	m := msg.ProtoReflect()
+	for _, name := range names {
+		if fd := m.Descriptor().Fields().ByName(name); fd != nil && fd.Kind() == protoreflect.Int32Kind {
+			h, err := strconv.ParseInt(handle, 10, 32)
+			if err != nil {
+				return invalidArgumentError(errGatewayHandle)
+			}
+			m.Set(fd, protoreflect.ValueOfInt32(int32(h)))
+			return nil
+		}
+	}
+	return status.Errorf(codes.NotFound, "%s does not take a handle of %s", method, resource)
+}
+
+// writeGatewayError writes err as the JSON form of its google.rpc.Status. The HTTP status
+// is derived from the gRPC code unless httpStatus is set.
+func writeGatewayError(w http.ResponseWriter, err error, httpStatus int) {
+	st := status.Convert(err)
+	if httpStatus == 0 {
+		httpStatus = httpStatusFromCode(st.Code())
+	}
+
+	data, marshalErr := protojson.Marshal(st.Proto())
+	if marshalErr != nil {
+		data = []byte(`{"code":13,"message":"failed to marshal error"}`)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(httpStatus)
+	_, _ = w.Write(data)
+}
+
+func httpStatusFromCode(c codes.Code) int {
+	switch c {
+	case codes.OK:
+		return http.StatusOK
+	case codes.Canceled:
+		return 499
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func gatewayPost(t *testing.T, ts *httptest.Server, path string, body string) (int, map[string]interface{}) {
+	t.Helper()
+	resp, err := http.Post(ts.URL+path, "application/json", strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	var out map[string]interface{}
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		t.Fatal(err)
+	}
+	return resp.StatusCode, out
+}
+
+func TestGateway(t *testing.T) {
+	e := newTestEngine(t, "file", "../examples/rbac_policy.csv", "../examples/rbac_model.conf")
+	ts := httptest.NewServer(NewGateway(e.s, RecoveryUnaryInterceptor))
+	defer ts.Close()
+
+	code, out := gatewayPost(t, ts, "/v1/new-adapter", `{"driverName": "file", "connectString": "../examples/rbac_policy.csv"}`)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, float64(1), out["handler"])
+
+	modelText, _ := json.Marshal(e.modelText)
+	code, out = gatewayPost(t, ts, "/v1/new-enforcer", `{"modelText": `+string(modelText)+`, "adapterHandle": 1}`)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, float64(1), out["handler"])
+
+	code, out = gatewayPost(t, ts, "/v1/enforcers/1/enforce", `{"params": ["alice", "data2", "read"]}`)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, true, out["res"])
+
+	code, out = gatewayPost(t, ts, "/v1/enforcers/1/enforce", `{"params": ["bob", "data1", "read"]}`)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, false, out["res"])
+
+	code, _ = gatewayPost(t, ts, "/v1/enforcers/1/add-policy", `{"params": ["bob", "data1", "read"]}`)
+	assert.Equal(t, http.StatusOK, code)
+
+	code, out = gatewayPost(t, ts, "/v1/enforce", `{"enforcerHandler": 1, "params": ["bob", "data1", "read"]}`)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, true, out["res"])
+
+	code, out = gatewayPost(t, ts, "/v1/adapters/1/free-adapter", ``)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Empty(t, out)
+}
+
+func TestGatewayErrors(t *testing.T) {
+	e := newTestEngine(t, "file", "../examples/rbac_policy.csv", "../examples/rbac_model.conf")
+	ts := httptest.NewServer(NewGateway(e.s, RecoveryUnaryInterceptor))
+	defer ts.Close()
+
+	code, out := gatewayPost(t, ts, "/v1/enforcers/7/enforce", `{"params": ["alice", "data1", "read"]}`)
+	assert.Equal(t, http.StatusNotFound, code)
+	assert.Equal(t, "enforcer not found", out["message"])
+	details := out["details"].([]interface{})
+	assert.Equal(t, ReasonEnforcerNotFound, details[0].(map[string]interface{})["reason"])
+
+	code, _ = gatewayPost(t, ts, "/v1/enforcers/0/enforce", `{"params": ["alice"]}`)
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	code, _ = gatewayPost(t, ts, "/v1/enforcers/0/enforce", `{"params": `)
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	code, _ = gatewayPost(t, ts, "/v1/enforcers/x/enforce", `{}`)
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	code, _ = gatewayPost(t, ts, "/v1/enforcers/0/no-such-rpc", `{}`)
+	assert.Equal(t, http.StatusNotFound, code)
+
+	code, _ = gatewayPost(t, ts, "/v1/enforcers/0/enforce", `{"params": ["`+strings.Repeat("a", maxGatewayBodySize)+`"]}`)
+	assert.Equal(t, http.StatusRequestEntityTooLarge, code)
+
+	// The handle in the path only goes to a field of the same kind.
+	code, _ = gatewayPost(t, ts, "/v1/enforcers/0/free-adapter", ``)
+	assert.Equal(t, http.StatusNotFound, code)
+	code, _ = gatewayPost(t, ts, "/v1/adapters/0/enforce", `{"params": ["alice", "data1", "read"]}`)
+	assert.Equal(t, http.StatusNotFound, code)
+	code, _ = gatewayPost(t, ts, "/v1/adapters/0/load-policy", ``)
+	assert.Equal(t, http.StatusNotFound, code)
+	_, err := e.s.getAdapter(0)
+	assert.NoError(t, err)
+
+	resp, err := http.Get(ts.URL + "/v1/enforcers/0/enforce")
+	assert.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+}
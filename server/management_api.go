@@ -16,10 +16,28 @@ package server
 
 import (
 	"context"
+	"errors"
 
 	pb "github.com/casbin/casbin-server/proto"
+	"github.com/casbin/casbin/v2"
+	"github.com/casbin/casbin/v2/persist"
+	fileadapter "github.com/casbin/casbin/v2/persist/file-adapter"
 )
 
+var errRulesLength = errors.New("the number of old rules must be equal to the number of new rules")
+
+// canUpdateFilteredPolicies reports whether casbin can update filtered policies of e.
+// casbin learns which rules to replace from the adapter, so this only works with
+// adapters that store the policy themselves.
+func canUpdateFilteredPolicies(e *casbin.SyncedEnforcer) bool {
+	switch e.GetAdapter().(type) {
+	case nil, *fileadapter.Adapter:
+		return false
+	}
+	_, ok := e.GetAdapter().(persist.UpdatableAdapter)
+	return ok
+}
+
 func (s *Server) wrapPlainPolicy(policy [][]string) *pb.Array2DReply {
 	if len(policy) == 0 {
 		return &pb.Array2DReply{}
@@ -34,6 +52,80 @@ func (s *Server) wrapPlainPolicy(policy [][]string) *pb.Array2DReply {
 	return policyReply
 }
 
+func (s *Server) unwrapPolicies(rules []*pb.PoliciesRequestRule) [][]string {
+	policies := make([][]string, len(rules))
+	for i := range rules {
+		policies[i] = rules[i].Params
+	}
+
+	return policies
+}
+
+// removeRules removes rules from the named policy or grouping policy of e if every one of
+// them exists, and returns whether they were removed. The rules are looked up and removed
+// while holding the lock of e, so that a concurrent change cannot make the removal partial.
+func removeRules(e *casbin.SyncedEnforcer, sec string, ptype string, rules [][]string) (bool, error) {
+	e.GetLock().Lock()
+	defer e.GetLock().Unlock()
+
+	for _, rule := range rules {
+		hasRule, err := e.GetModel().HasPolicy(sec, ptype, rule)
+		if !hasRule || err != nil {
+			return false, invalidArgumentError(err)
+		}
+	}
+	if sec == "g" {
+		return e.Enforcer.RemoveNamedGroupingPolicies(ptype, rules)
+	}
+	return e.Enforcer.RemoveNamedPolicies(ptype, rules)
+}
+
+// removeFilteredRules removes the rules of the named policy or grouping policy of e
+// matching the field filters, and returns the rules removed. The rules are looked up and
+// removed while holding the lock of e, so that the watchers are told exactly which ones.
+func removeFilteredRules(e *casbin.SyncedEnforcer, sec string, ptype string, fieldIndex int, fieldValues ...string) ([][]string, error) {
+	e.GetLock().Lock()
+	defer e.GetLock().Unlock()
+	return removeFilteredRulesLocked(e, sec, ptype, fieldIndex, fieldValues...)
+}
+
+// removeFilteredRulesLocked is removeFilteredRules for callers holding the lock of e.
+func removeFilteredRulesLocked(e *casbin.SyncedEnforcer, sec string, ptype string, fieldIndex int, fieldValues ...string) ([][]string, error) {
+	rules, err := e.GetModel().GetFilteredPolicy(sec, ptype, fieldIndex, fieldValues...)
+	if err != nil {
+		return nil, err
+	}
+	var ruleRemoved bool
+	if sec == "g" {
+		ruleRemoved, err = e.Enforcer.RemoveFilteredNamedGroupingPolicy(ptype, fieldIndex, fieldValues...)
+	} else {
+		ruleRemoved, err = e.Enforcer.RemoveFilteredNamedPolicy(ptype, fieldIndex, fieldValues...)
+	}
+	if !ruleRemoved {
+		return nil, err
+	}
+	return rules, err
+}
+
+// addRule adds rule to the named policy or grouping policy of e, and returns the reply of
+// casbin and whether the rule is new: casbin also reports a rule that already exists as
+// added. The rule is looked up and added while holding the lock of e.
+func addRule(e *casbin.SyncedEnforcer, sec string, ptype string, rule []string) (ruleAdded bool, isNew bool, err error) {
+	e.GetLock().Lock()
+	defer e.GetLock().Unlock()
+
+	hasRule, err := e.GetModel().HasPolicy(sec, ptype, rule)
+	if err != nil {
+		return false, false, err
+	}
+	if sec == "g" {
+		ruleAdded, err = e.Enforcer.AddNamedGroupingPolicy(ptype, rule)
+	} else {
+		ruleAdded, err = e.Enforcer.AddNamedPolicy(ptype, rule)
+	}
+	return ruleAdded, ruleAdded && !hasRule, err
+}
+
 // GetAllSubjects gets the list of subjects that show up in the current policy.
 func (s *Server) GetAllSubjects(ctx context.Context, in *pb.EmptyRequest) (*pb.ArrayReply, error) {
 	return s.GetAllNamedSubjects(ctx, &pb.SimpleGetRequest{EnforcerHandler: in.Handler, PType: "p"})
@@ -46,9 +138,15 @@ func (s *Server) GetAllNamedSubjects(ctx context.Context, in *pb.SimpleGetReques
 		return &pb.ArrayReply{}, err
 	}
 
+	if err = checkFieldIndex(e, "p", in.PType, 0, 1); err != nil {
+		return &pb.ArrayReply{}, err
+	}
+
+	e.GetLock().RLock()
 	valuesForFieldInPolicy, err := e.GetModel().GetValuesForFieldInPolicy("p", in.PType, 0)
+	e.GetLock().RUnlock()
 	if err != nil {
-		return &pb.ArrayReply{}, err
+		return &pb.ArrayReply{}, invalidArgumentError(err)
 	}
 
 	return &pb.ArrayReply{Array: valuesForFieldInPolicy}, nil
@@ -66,9 +164,15 @@ func (s *Server) GetAllNamedObjects(ctx context.Context, in *pb.SimpleGetRequest
 		return &pb.ArrayReply{}, err
 	}
 
+	if err = checkFieldIndex(e, "p", in.PType, 1, 1); err != nil {
+		return &pb.ArrayReply{}, err
+	}
+
+	e.GetLock().RLock()
 	valuesForFieldInPolicy, err := e.GetModel().GetValuesForFieldInPolicy("p", in.PType, 1)
+	e.GetLock().RUnlock()
 	if err != nil {
-		return &pb.ArrayReply{}, err
+		return &pb.ArrayReply{}, invalidArgumentError(err)
 	}
 
 	return &pb.ArrayReply{Array: valuesForFieldInPolicy}, nil
@@ -86,9 +190,15 @@ func (s *Server) GetAllNamedActions(ctx context.Context, in *pb.SimpleGetRequest
 		return &pb.ArrayReply{}, err
 	}
 
+	if err = checkFieldIndex(e, "p", in.PType, 2, 1); err != nil {
+		return &pb.ArrayReply{}, err
+	}
+
+	e.GetLock().RLock()
 	valuesForFieldInPolicy, err := e.GetModel().GetValuesForFieldInPolicy("p", in.PType, 2)
+	e.GetLock().RUnlock()
 	if err != nil {
-		return &pb.ArrayReply{}, err
+		return &pb.ArrayReply{}, invalidArgumentError(err)
 	}
 
 	return &pb.ArrayReply{Array: valuesForFieldInPolicy}, nil
@@ -106,9 +216,15 @@ func (s *Server) GetAllNamedRoles(ctx context.Context, in *pb.SimpleGetRequest)
 		return &pb.ArrayReply{}, err
 	}
 
+	if err = checkFieldIndex(e, "g", in.PType, 1, 1); err != nil {
+		return &pb.ArrayReply{}, err
+	}
+
+	e.GetLock().RLock()
 	valuesForFieldInPolicy, err := e.GetModel().GetValuesForFieldInPolicy("g", in.PType, 1)
+	e.GetLock().RUnlock()
 	if err != nil {
-		return &pb.ArrayReply{}, err
+		return &pb.ArrayReply{}, invalidArgumentError(err)
 	}
 
 	return &pb.ArrayReply{Array: valuesForFieldInPolicy}, nil
@@ -126,9 +242,11 @@ func (s *Server) GetNamedPolicy(ctx context.Context, in *pb.PolicyRequest) (*pb.
 		return &pb.Array2DReply{}, err
 	}
 
+	e.GetLock().RLock()
 	policy, err := e.GetModel().GetPolicy("p", in.PType)
+	e.GetLock().RUnlock()
 	if err != nil {
-		return &pb.Array2DReply{}, err
+		return &pb.Array2DReply{}, invalidArgumentError(err)
 	}
 
 	return s.wrapPlainPolicy(policy), nil
@@ -148,9 +266,15 @@ func (s *Server) GetFilteredNamedPolicy(ctx context.Context, in *pb.FilteredPoli
 		return &pb.Array2DReply{}, err
 	}
 
+	if err = checkFieldIndex(e, "p", in.PType, int(in.FieldIndex), len(in.FieldValues)); err != nil {
+		return &pb.Array2DReply{}, err
+	}
+
+	e.GetLock().RLock()
 	filteredPolicy, err := e.GetModel().GetFilteredPolicy("p", in.PType, int(in.FieldIndex), in.FieldValues...)
+	e.GetLock().RUnlock()
 	if err != nil {
-		return &pb.Array2DReply{}, err
+		return &pb.Array2DReply{}, invalidArgumentError(err)
 	}
 
 	return s.wrapPlainPolicy(filteredPolicy), nil
@@ -168,9 +292,11 @@ func (s *Server) GetNamedGroupingPolicy(ctx context.Context, in *pb.PolicyReques
 		return &pb.Array2DReply{}, err
 	}
 
+	e.GetLock().RLock()
 	policy, err := e.GetModel().GetPolicy("g", in.PType)
+	e.GetLock().RUnlock()
 	if err != nil {
-		return &pb.Array2DReply{}, err
+		return &pb.Array2DReply{}, invalidArgumentError(err)
 	}
 
 	return s.wrapPlainPolicy(policy), nil
@@ -190,9 +316,15 @@ func (s *Server) GetFilteredNamedGroupingPolicy(ctx context.Context, in *pb.Filt
 		return &pb.Array2DReply{}, err
 	}
 
+	if err = checkFieldIndex(e, "g", in.PType, int(in.FieldIndex), len(in.FieldValues)); err != nil {
+		return &pb.Array2DReply{}, err
+	}
+
+	e.GetLock().RLock()
 	filteredPolicy, err := e.GetModel().GetFilteredPolicy("g", in.PType, int(in.FieldIndex), in.FieldValues...)
+	e.GetLock().RUnlock()
 	if err != nil {
-		return &pb.Array2DReply{}, err
+		return &pb.Array2DReply{}, invalidArgumentError(err)
 	}
 
 	return s.wrapPlainPolicy(filteredPolicy), nil
@@ -210,9 +342,11 @@ func (s *Server) HasNamedPolicy(ctx context.Context, in *pb.PolicyRequest) (*pb.
 		return &pb.BoolReply{}, err
 	}
 
+	e.GetLock().RLock()
 	hasPolicy, err := e.GetModel().HasPolicy("p", in.PType, in.Params)
+	e.GetLock().RUnlock()
 	if err != nil {
-		return &pb.BoolReply{}, err
+		return &pb.BoolReply{}, invalidArgumentError(err)
 	}
 
 	return &pb.BoolReply{Res: hasPolicy}, nil
@@ -231,9 +365,11 @@ func (s *Server) HasNamedGroupingPolicy(ctx context.Context, in *pb.PolicyReques
 		return &pb.BoolReply{}, err
 	}
 
+	e.GetLock().RLock()
 	haPolicy, err := e.GetModel().HasPolicy("g", in.PType, in.Params)
+	e.GetLock().RUnlock()
 	if err != nil {
-		return &pb.BoolReply{}, err
+		return &pb.BoolReply{}, invalidArgumentError(err)
 	}
 
 	return &pb.BoolReply{Res: haPolicy}, nil
@@ -250,8 +386,11 @@ func (s *Server) AddNamedPolicy(ctx context.Context, in *pb.PolicyRequest) (*pb.
 		return &pb.BoolReply{}, err
 	}
 
-	ruleAdded, err := e.AddNamedPolicy(in.PType, in.Params)
-	return &pb.BoolReply{Res: ruleAdded}, err
+	ruleAdded, isNew, err := addRule(e, "p", in.PType, in.Params)
+	if isNew && err == nil {
+		s.notifyPolicy(in.EnforcerHandler, pb.PolicyEvent_ADD, in.PType, [][]string{in.Params})
+	}
+	return &pb.BoolReply{Res: ruleAdded}, policyError(e, "p", in.PType, err)
 }
 
 func (s *Server) RemovePolicy(ctx context.Context, in *pb.PolicyRequest) (*pb.BoolReply, error) {
@@ -266,7 +405,10 @@ func (s *Server) RemoveNamedPolicy(ctx context.Context, in *pb.PolicyRequest) (*
 	}
 
 	ruleRemoved, err := e.RemoveNamedPolicy(in.PType, in.Params)
-	return &pb.BoolReply{Res: ruleRemoved}, err
+	if ruleRemoved && err == nil {
+		s.notifyPolicy(in.EnforcerHandler, pb.PolicyEvent_REMOVE, in.PType, [][]string{in.Params})
+	}
+	return &pb.BoolReply{Res: ruleRemoved}, policyError(e, "p", in.PType, err)
 }
 
 // RemoveFilteredPolicy removes an authorization rule from the current policy, field filters can be specified.
@@ -282,8 +424,15 @@ func (s *Server) RemoveFilteredNamedPolicy(ctx context.Context, in *pb.FilteredP
 		return &pb.BoolReply{}, err
 	}
 
-	ruleRemoved, err := e.RemoveFilteredNamedPolicy(in.PType, int(in.FieldIndex), in.FieldValues...)
-	return &pb.BoolReply{Res: ruleRemoved}, err
+	if err = checkFieldIndex(e, "p", in.PType, int(in.FieldIndex), len(in.FieldValues)); err != nil {
+		return &pb.BoolReply{}, err
+	}
+
+	rules, err := removeFilteredRules(e, "p", in.PType, int(in.FieldIndex), in.FieldValues...)
+	if len(rules) > 0 && err == nil {
+		s.notifyPolicy(in.EnforcerHandler, pb.PolicyEvent_REMOVE, in.PType, rules)
+	}
+	return &pb.BoolReply{Res: len(rules) > 0}, policyError(e, "p", in.PType, err)
 }
 
 // AddGroupingPolicy adds a role inheritance rule to the current policy.
@@ -303,8 +452,11 @@ func (s *Server) AddNamedGroupingPolicy(ctx context.Context, in *pb.PolicyReques
 		return &pb.BoolReply{}, err
 	}
 
-	ruleAdded, err := e.AddNamedGroupingPolicy(in.PType, in.Params)
-	return &pb.BoolReply{Res: ruleAdded}, err
+	ruleAdded, isNew, err := addRule(e, "g", in.PType, in.Params)
+	if isNew && err == nil {
+		s.notifyPolicy(in.EnforcerHandler, pb.PolicyEvent_ADD, in.PType, [][]string{in.Params})
+	}
+	return &pb.BoolReply{Res: ruleAdded}, policyError(e, "g", in.PType, err)
 }
 
 // RemoveGroupingPolicy removes a role inheritance rule from the current policy.
@@ -321,7 +473,10 @@ func (s *Server) RemoveNamedGroupingPolicy(ctx context.Context, in *pb.PolicyReq
 	}
 
 	ruleRemoved, err := e.RemoveNamedGroupingPolicy(in.PType, in.Params)
-	return &pb.BoolReply{Res: ruleRemoved}, err
+	if ruleRemoved && err == nil {
+		s.notifyPolicy(in.EnforcerHandler, pb.PolicyEvent_REMOVE, in.PType, [][]string{in.Params})
+	}
+	return &pb.BoolReply{Res: ruleRemoved}, policyError(e, "g", in.PType, err)
 }
 
 // RemoveFilteredGroupingPolicy removes a role inheritance rule from the current policy, field filters can be specified.
@@ -337,6 +492,278 @@ func (s *Server) RemoveFilteredNamedGroupingPolicy(ctx context.Context, in *pb.F
 		return &pb.BoolReply{}, err
 	}
 
-	ruleRemoved, err := e.RemoveFilteredNamedGroupingPolicy(in.PType, int(in.FieldIndex), in.FieldValues...)
-	return &pb.BoolReply{Res: ruleRemoved}, err
+	if err = checkFieldIndex(e, "g", in.PType, int(in.FieldIndex), len(in.FieldValues)); err != nil {
+		return &pb.BoolReply{}, err
+	}
+
+	rules, err := removeFilteredRules(e, "g", in.PType, int(in.FieldIndex), in.FieldValues...)
+	if len(rules) > 0 && err == nil {
+		s.notifyPolicy(in.EnforcerHandler, pb.PolicyEvent_REMOVE, in.PType, rules)
+	}
+	return &pb.BoolReply{Res: len(rules) > 0}, policyError(e, "g", in.PType, err)
+}
+
+// AddPolicies adds authorization rules to the current policy.
+// If any of the rules already exists, the function returns false and none of the rules are added.
+// Otherwise the function returns true by adding all the rules.
+func (s *Server) AddPolicies(ctx context.Context, in *pb.PoliciesRequest) (*pb.BoolReply, error) {
+	in.PType = "p"
+	return s.AddNamedPolicies(ctx, in)
+}
+
+// AddNamedPolicies adds authorization rules to the current named policy.
+// If any of the rules already exists, the function returns false and none of the rules are added.
+// Otherwise the function returns true by adding all the rules.
+func (s *Server) AddNamedPolicies(ctx context.Context, in *pb.PoliciesRequest) (*pb.BoolReply, error) {
+	e, err := s.getEnforcer(int(in.EnforcerHandler))
+	if err != nil {
+		return &pb.BoolReply{}, err
+	}
+
+	rules := s.unwrapPolicies(in.Rules)
+	rulesAdded, err := e.AddNamedPolicies(in.PType, rules)
+	if rulesAdded && err == nil {
+		s.notifyPolicy(in.EnforcerHandler, pb.PolicyEvent_ADD, in.PType, rules)
+	}
+	return &pb.BoolReply{Res: rulesAdded}, policyError(e, "p", in.PType, err)
+}
+
+// RemovePolicies removes authorization rules from the current policy.
+// If any of the rules does not exist, the function returns false and none of the rules are removed.
+func (s *Server) RemovePolicies(ctx context.Context, in *pb.PoliciesRequest) (*pb.BoolReply, error) {
+	in.PType = "p"
+	return s.RemoveNamedPolicies(ctx, in)
+}
+
+// RemoveNamedPolicies removes authorization rules from the current named policy.
+// If any of the rules does not exist, the function returns false and none of the rules are removed.
+func (s *Server) RemoveNamedPolicies(ctx context.Context, in *pb.PoliciesRequest) (*pb.BoolReply, error) {
+	e, err := s.getEnforcer(int(in.EnforcerHandler))
+	if err != nil {
+		return &pb.BoolReply{}, err
+	}
+
+	rules := s.unwrapPolicies(in.Rules)
+	rulesRemoved, err := removeRules(e, "p", in.PType, rules)
+	if rulesRemoved && err == nil {
+		s.notifyPolicy(in.EnforcerHandler, pb.PolicyEvent_REMOVE, in.PType, rules)
+	}
+	return &pb.BoolReply{Res: rulesRemoved}, policyError(e, "p", in.PType, err)
+}
+
+// AddGroupingPolicies adds role inheritance rules to the current policy.
+// If any of the rules already exists, the function returns false and none of the rules are added.
+// Otherwise the function returns true by adding all the rules.
+func (s *Server) AddGroupingPolicies(ctx context.Context, in *pb.PoliciesRequest) (*pb.BoolReply, error) {
+	in.PType = "g"
+	return s.AddNamedGroupingPolicies(ctx, in)
+}
+
+// AddNamedGroupingPolicies adds named role inheritance rules to the current policy.
+// If any of the rules already exists, the function returns false and none of the rules are added.
+// Otherwise the function returns true by adding all the rules.
+func (s *Server) AddNamedGroupingPolicies(ctx context.Context, in *pb.PoliciesRequest) (*pb.BoolReply, error) {
+	e, err := s.getEnforcer(int(in.EnforcerHandler))
+	if err != nil {
+		return &pb.BoolReply{}, err
+	}
+
+	rules := s.unwrapPolicies(in.Rules)
+	rulesAdded, err := e.AddNamedGroupingPolicies(in.PType, rules)
+	if rulesAdded && err == nil {
+		s.notifyPolicy(in.EnforcerHandler, pb.PolicyEvent_ADD, in.PType, rules)
+	}
+	return &pb.BoolReply{Res: rulesAdded}, policyError(e, "g", in.PType, err)
+}
+
+// RemoveGroupingPolicies removes role inheritance rules from the current policy.
+// If any of the rules does not exist, the function returns false and none of the rules are removed.
+func (s *Server) RemoveGroupingPolicies(ctx context.Context, in *pb.PoliciesRequest) (*pb.BoolReply, error) {
+	in.PType = "g"
+	return s.RemoveNamedGroupingPolicies(ctx, in)
+}
+
+// RemoveNamedGroupingPolicies removes role inheritance rules from the current named policy.
+// If any of the rules does not exist, the function returns false and none of the rules are removed.
+func (s *Server) RemoveNamedGroupingPolicies(ctx context.Context, in *pb.PoliciesRequest) (*pb.BoolReply, error) {
+	e, err := s.getEnforcer(int(in.EnforcerHandler))
+	if err != nil {
+		return &pb.BoolReply{}, err
+	}
+
+	rules := s.unwrapPolicies(in.Rules)
+	rulesRemoved, err := removeRules(e, "g", in.PType, rules)
+	if rulesRemoved && err == nil {
+		s.notifyPolicy(in.EnforcerHandler, pb.PolicyEvent_REMOVE, in.PType, rules)
+	}
+	return &pb.BoolReply{Res: rulesRemoved}, policyError(e, "g", in.PType, err)
+}
+
+// UpdatePolicy updates an authorization rule in the current policy.
+// Returns false if the old rule does not exist.
+func (s *Server) UpdatePolicy(ctx context.Context, in *pb.UpdatePolicyRequest) (*pb.BoolReply, error) {
+	in.PType = "p"
+	return s.UpdateNamedPolicy(ctx, in)
+}
+
+// UpdateNamedPolicy updates an authorization rule in the current named policy.
+// Returns false if the old rule does not exist.
+func (s *Server) UpdateNamedPolicy(ctx context.Context, in *pb.UpdatePolicyRequest) (*pb.BoolReply, error) {
+	e, err := s.getEnforcer(int(in.EnforcerHandler))
+	if err != nil {
+		return &pb.BoolReply{}, err
+	}
+
+	ruleUpdated, err := e.UpdateNamedPolicy(in.PType, in.OldRule, in.NewRule)
+	if ruleUpdated && err == nil {
+		s.notifyPolicyUpdate(in.EnforcerHandler, in.PType, [][]string{in.OldRule}, [][]string{in.NewRule})
+	}
+	return &pb.BoolReply{Res: ruleUpdated}, policyError(e, "p", in.PType, err)
+}
+
+// UpdatePolicies updates authorization rules in the current policy.
+// The old rules are replaced by the new rules at the same position.
+func (s *Server) UpdatePolicies(ctx context.Context, in *pb.UpdatePoliciesRequest) (*pb.BoolReply, error) {
+	in.PType = "p"
+	return s.UpdateNamedPolicies(ctx, in)
+}
+
+// UpdateNamedPolicies updates authorization rules in the current named policy.
+// The old rules are replaced by the new rules at the same position.
+func (s *Server) UpdateNamedPolicies(ctx context.Context, in *pb.UpdatePoliciesRequest) (*pb.BoolReply, error) {
+	e, err := s.getEnforcer(int(in.EnforcerHandler))
+	if err != nil {
+		return &pb.BoolReply{}, err
+	}
+	if len(in.OldRules) != len(in.NewRules) {
+		return &pb.BoolReply{}, invalidArgumentError(errRulesLength)
+	}
+
+	oldRules, newRules := s.unwrapPolicies(in.OldRules), s.unwrapPolicies(in.NewRules)
+	rulesUpdated, err := e.UpdateNamedPolicies(in.PType, oldRules, newRules)
+	if rulesUpdated && err == nil {
+		s.notifyPolicyUpdate(in.EnforcerHandler, in.PType, oldRules, newRules)
+	}
+	return &pb.BoolReply{Res: rulesUpdated}, policyError(e, "p", in.PType, err)
+}
+
+// UpdateFilteredPolicies replaces the authorization rules matching the field filters with new rules.
+func (s *Server) UpdateFilteredPolicies(ctx context.Context, in *pb.UpdateFilteredPoliciesRequest) (*pb.BoolReply, error) {
+	in.PType = "p"
+	return s.UpdateFilteredNamedPolicies(ctx, in)
+}
+
+// UpdateFilteredNamedPolicies replaces the authorization rules of the named policy matching the field filters with new rules.
+func (s *Server) UpdateFilteredNamedPolicies(ctx context.Context, in *pb.UpdateFilteredPoliciesRequest) (*pb.BoolReply, error) {
+	e, err := s.getEnforcer(int(in.EnforcerHandler))
+	if err != nil {
+		return &pb.BoolReply{}, err
+	}
+
+	if err = checkFieldIndex(e, "p", in.PType, int(in.FieldIndex), len(in.FieldValues)); err != nil {
+		return &pb.BoolReply{}, err
+	}
+
+	newRules := s.unwrapPolicies(in.NewRules)
+	oldRules, err := s.updateFilteredPolicies(e, int(in.EnforcerHandler), in.PType, newRules, int(in.FieldIndex), in.FieldValues...)
+	if len(oldRules) > 0 && err == nil {
+		s.notifyPolicyUpdate(in.EnforcerHandler, in.PType, oldRules, newRules)
+	}
+	return &pb.BoolReply{Res: len(oldRules) > 0}, policyError(e, "p", in.PType, err)
+}
+
+// updateFilteredPolicies replaces the rules of the named policy matching the field filters
+// with newRules and returns the replaced rules, even if notifying the watcher then fails.
+// The rules are matched and replaced while holding the lock of e, so that other callers
+// see either the old rules or the new ones.
+func (s *Server) updateFilteredPolicies(e *casbin.SyncedEnforcer, handle int, ptype string, newRules [][]string, fieldIndex int, fieldValues ...string) ([][]string, error) {
+	e.GetLock().Lock()
+	defer e.GetLock().Unlock()
+
+	m := e.GetModel()
+	oldRules, err := m.GetFilteredPolicy("p", ptype, fieldIndex, fieldValues...)
+	if err != nil || len(oldRules) == 0 {
+		return nil, err
+	}
+
+	if canUpdateFilteredPolicies(e) {
+		// The adapter replaces the rules in a single call, before the model is changed.
+		rulesUpdated, err := e.Enforcer.UpdateFilteredNamedPolicies(ptype, newRules, fieldIndex, fieldValues...)
+		if !rulesUpdated {
+			return nil, err
+		}
+		return oldRules, err
+	}
+
+	// Without such an adapter the policy only lives in the model, so the rules are
+	// replaced there, and put back if the new ones cannot be added. New rules that
+	// already exist elsewhere in the policy are kept as they are.
+	if _, err := m.RemovePolicies("p", ptype, oldRules); err != nil {
+		return nil, err
+	}
+	if added, err := m.AddPoliciesWithAffected("p", ptype, newRules); err != nil {
+		_, _ = m.RemovePolicies("p", ptype, added)
+		_ = m.AddPolicies("p", ptype, oldRules)
+		return nil, err
+	}
+
+	if w := s.getWatcher(handle); w != nil {
+		if uw, ok := w.(persist.UpdatableWatcher); ok {
+			err = uw.UpdateForUpdatePolicies("p", ptype, oldRules, newRules)
+		} else {
+			err = w.Update()
+		}
+		if err != nil {
+			return oldRules, watcherError(err)
+		}
+	}
+	return oldRules, nil
+}
+
+// UpdateGroupingPolicy updates a role inheritance rule in the current policy.
+// Returns false if the old rule does not exist.
+func (s *Server) UpdateGroupingPolicy(ctx context.Context, in *pb.UpdatePolicyRequest) (*pb.BoolReply, error) {
+	in.PType = "g"
+	return s.UpdateNamedGroupingPolicy(ctx, in)
+}
+
+// UpdateNamedGroupingPolicy updates a named role inheritance rule in the current policy.
+// Returns false if the old rule does not exist.
+func (s *Server) UpdateNamedGroupingPolicy(ctx context.Context, in *pb.UpdatePolicyRequest) (*pb.BoolReply, error) {
+	e, err := s.getEnforcer(int(in.EnforcerHandler))
+	if err != nil {
+		return &pb.BoolReply{}, err
+	}
+
+	ruleUpdated, err := e.UpdateNamedGroupingPolicy(in.PType, in.OldRule, in.NewRule)
+	if ruleUpdated && err == nil {
+		s.notifyPolicyUpdate(in.EnforcerHandler, in.PType, [][]string{in.OldRule}, [][]string{in.NewRule})
+	}
+	return &pb.BoolReply{Res: ruleUpdated}, policyError(e, "g", in.PType, err)
+}
+
+// UpdateGroupingPolicies updates role inheritance rules in the current policy.
+// The old rules are replaced by the new rules at the same position.
+func (s *Server) UpdateGroupingPolicies(ctx context.Context, in *pb.UpdatePoliciesRequest) (*pb.BoolReply, error) {
+	in.PType = "g"
+	return s.UpdateNamedGroupingPolicies(ctx, in)
+}
+
+// UpdateNamedGroupingPolicies updates named role inheritance rules in the current policy.
+// The old rules are replaced by the new rules at the same position.
+func (s *Server) UpdateNamedGroupingPolicies(ctx context.Context, in *pb.UpdatePoliciesRequest) (*pb.BoolReply, error) {
+	e, err := s.getEnforcer(int(in.EnforcerHandler))
+	if err != nil {
+		return &pb.BoolReply{}, err
+	}
+	if len(in.OldRules) != len(in.NewRules) {
+		return &pb.BoolReply{}, invalidArgumentError(errRulesLength)
+	}
+
+	oldRules, newRules := s.unwrapPolicies(in.OldRules), s.unwrapPolicies(in.NewRules)
+	rulesUpdated, err := e.UpdateNamedGroupingPolicies(in.PType, oldRules, newRules)
+	if rulesUpdated && err == nil {
+		s.notifyPolicyUpdate(in.EnforcerHandler, in.PType, oldRules, newRules)
+	}
+	return &pb.BoolReply{Res: rulesUpdated}, policyError(e, "g", in.PType, err)
 }
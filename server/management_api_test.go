@@ -15,11 +15,14 @@
 package server
 
 import (
+	"sync"
 	"testing"
 
 	pb "github.com/casbin/casbin-server/proto"
 	"github.com/casbin/casbin/v2/util"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func testStringList(t *testing.T, title string, f func() []string, res []string) {
@@ -306,3 +309,213 @@ func TestModifyGroupingPolicyAPI(t *testing.T) {
 	testGetUsers(t, e, "data2_admin", []string{})
 	testGetUsers(t, e, "data3_admin", []string{"george","eve"})
 }
+
+func TestModifyPoliciesAPI(t *testing.T) {
+	e := newTestEngine(t, "file", "../examples/rbac_policy.csv", "../examples/rbac_model.conf")
+
+	reply, err := e.s.AddPolicies(e.ctx, &pb.PoliciesRequest{EnforcerHandler: e.h, Rules: []*pb.PoliciesRequestRule{
+		{Params: []string{"eve", "data3", "read"}},
+		{Params: []string{"eve", "data3", "write"}},
+	}})
+	assert.NoError(t, err)
+	assert.True(t, reply.Res)
+
+	// All or nothing: one rule already exists, so none is added.
+	reply, err = e.s.AddPolicies(e.ctx, &pb.PoliciesRequest{EnforcerHandler: e.h, Rules: []*pb.PoliciesRequestRule{
+		{Params: []string{"frank", "data1", "read"}},
+		{Params: []string{"eve", "data3", "read"}},
+	}})
+	assert.NoError(t, err)
+	assert.False(t, reply.Res)
+
+	reply, err = e.s.RemoveNamedPolicies(e.ctx, &pb.PoliciesRequest{EnforcerHandler: e.h, PType: "p", Rules: []*pb.PoliciesRequestRule{
+		{Params: []string{"alice", "data1", "read"}},
+		{Params: []string{"bob", "data2", "write"}},
+	}})
+	assert.NoError(t, err)
+	assert.True(t, reply.Res)
+
+	testGetPolicy(t, e, [][]string{
+		{"data2_admin", "data2", "read"},
+		{"data2_admin", "data2", "write"},
+		{"data3_admin", "data3", "admin"},
+		{"data4_admin", "data4", "read"},
+		{"eve", "data3", "read"},
+		{"eve", "data3", "write"}})
+
+	_, err = e.s.AddNamedPolicies(e.ctx, &pb.PoliciesRequest{EnforcerHandler: e.h, PType: "p2", Rules: []*pb.PoliciesRequestRule{
+		{Params: []string{"frank", "data1", "read"}},
+	}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestRemovePoliciesAtomic(t *testing.T) {
+	// Of two batches sharing a rule, one is removed whole and the other not at all.
+	for i := 0; i < 20; i++ {
+		e := newTestEngine(t, "file", "../examples/rbac_policy.csv", "../examples/rbac_model.conf")
+		batches := [][][]string{
+			{{"alice", "data1", "read"}, {"bob", "data2", "write"}},
+			{{"bob", "data2", "write"}, {"data2_admin", "data2", "read"}},
+		}
+		removed := make([]bool, len(batches))
+		var wg sync.WaitGroup
+		for j, rules := range batches {
+			wg.Add(1)
+			go func(j int, rules [][]string) {
+				defer wg.Done()
+				reply, err := e.s.RemovePolicies(e.ctx, &pb.PoliciesRequest{EnforcerHandler: e.h, Rules: wrapRules(rules)})
+				assert.NoError(t, err)
+				removed[j] = reply.Res
+			}(j, rules)
+		}
+		wg.Wait()
+
+		assert.NotEqual(t, removed[0], removed[1])
+		reply, err := e.s.GetPolicy(e.ctx, &pb.EmptyRequest{Handler: e.h})
+		assert.NoError(t, err)
+		assert.Len(t, reply.D2, 4)
+	}
+}
+
+func TestModifyGroupingPoliciesAPI(t *testing.T) {
+	e := newTestEngine(t, "file", "../examples/rbac_policy.csv", "../examples/rbac_model.conf")
+
+	reply, err := e.s.AddGroupingPolicies(e.ctx, &pb.PoliciesRequest{EnforcerHandler: e.h, Rules: []*pb.PoliciesRequestRule{
+		{Params: []string{"bob", "data1_admin"}},
+		{Params: []string{"eve", "data3_admin"}},
+	}})
+	assert.NoError(t, err)
+	assert.True(t, reply.Res)
+
+	testGetRoles(t, e, "bob", []string{"data1_admin"})
+	testGetRoles(t, e, "eve", []string{"data3_admin"})
+
+	reply, err = e.s.RemoveGroupingPolicies(e.ctx, &pb.PoliciesRequest{EnforcerHandler: e.h, Rules: []*pb.PoliciesRequestRule{
+		{Params: []string{"alice", "data2_admin"}},
+		{Params: []string{"bob", "data1_admin"}},
+	}})
+	assert.NoError(t, err)
+	assert.True(t, reply.Res)
+
+	testGetRoles(t, e, "alice", []string{})
+	testGetRoles(t, e, "bob", []string{})
+	testGetUsers(t, e, "data3_admin", []string{"eve", "george"})
+
+	reply, err = e.s.RemoveNamedGroupingPolicies(e.ctx, &pb.PoliciesRequest{EnforcerHandler: e.h, PType: "g", Rules: []*pb.PoliciesRequestRule{
+		{Params: []string{"eve", "data3_admin"}},
+		{Params: []string{"bob", "data1_admin"}},
+	}})
+	assert.NoError(t, err)
+	assert.False(t, reply.Res)
+	testGetRoles(t, e, "eve", []string{"data3_admin"})
+
+	reply, err = e.s.AddNamedGroupingPolicies(e.ctx, &pb.PoliciesRequest{EnforcerHandler: e.h, PType: "g", Rules: []*pb.PoliciesRequestRule{
+		{Params: []string{"alice", "data2_admin"}},
+	}})
+	assert.NoError(t, err)
+	assert.True(t, reply.Res)
+	testGetRoles(t, e, "alice", []string{"data2_admin"})
+}
+
+func TestUpdatePolicyAPI(t *testing.T) {
+	e := newTestEngine(t, "file", "../examples/rbac_policy.csv", "../examples/rbac_model.conf")
+
+	reply, err := e.s.UpdatePolicy(e.ctx, &pb.UpdatePolicyRequest{EnforcerHandler: e.h,
+		OldRule: []string{"alice", "data1", "read"}, NewRule: []string{"alice", "data1", "write"}})
+	assert.NoError(t, err)
+	assert.True(t, reply.Res)
+
+	reply, err = e.s.UpdatePolicy(e.ctx, &pb.UpdatePolicyRequest{EnforcerHandler: e.h,
+		OldRule: []string{"alice", "data1", "read"}, NewRule: []string{"alice", "data1", "write"}})
+	assert.NoError(t, err)
+	assert.False(t, reply.Res)
+
+	reply, err = e.s.UpdatePolicies(e.ctx, &pb.UpdatePoliciesRequest{EnforcerHandler: e.h,
+		OldRules: []*pb.PoliciesRequestRule{{Params: []string{"bob", "data2", "write"}}, {Params: []string{"data4_admin", "data4", "read"}}},
+		NewRules: []*pb.PoliciesRequestRule{{Params: []string{"bob", "data2", "read"}}, {Params: []string{"data4_admin", "data4", "write"}}}})
+	assert.NoError(t, err)
+	assert.True(t, reply.Res)
+
+	_, err = e.s.UpdatePolicies(e.ctx, &pb.UpdatePoliciesRequest{EnforcerHandler: e.h,
+		OldRules: []*pb.PoliciesRequestRule{{Params: []string{"bob", "data2", "read"}}}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	testGetPolicy(t, e, [][]string{
+		{"alice", "data1", "write"},
+		{"bob", "data2", "read"},
+		{"data2_admin", "data2", "read"},
+		{"data2_admin", "data2", "write"},
+		{"data3_admin", "data3", "admin"},
+		{"data4_admin", "data4", "write"}})
+
+	reply, err = e.s.UpdateFilteredPolicies(e.ctx, &pb.UpdateFilteredPoliciesRequest{EnforcerHandler: e.h,
+		NewRules:   []*pb.PoliciesRequestRule{{Params: []string{"data2_admin", "data2", "admin"}}},
+		FieldIndex: 0, FieldValues: []string{"data2_admin"}})
+	assert.NoError(t, err)
+	assert.True(t, reply.Res)
+
+	testGetPolicy(t, e, [][]string{
+		{"alice", "data1", "write"},
+		{"bob", "data2", "read"},
+		{"data3_admin", "data3", "admin"},
+		{"data4_admin", "data4", "write"},
+		{"data2_admin", "data2", "admin"}})
+
+	reply, err = e.s.UpdateFilteredPolicies(e.ctx, &pb.UpdateFilteredPoliciesRequest{EnforcerHandler: e.h,
+		NewRules:   []*pb.PoliciesRequestRule{{Params: []string{"eve", "data2", "admin"}}},
+		FieldIndex: 0, FieldValues: []string{"eve"}})
+	assert.NoError(t, err)
+	assert.False(t, reply.Res)
+}
+
+func TestUpdateFilteredPoliciesAtomic(t *testing.T) {
+	e := newTestEngine(t, "file", "../examples/rbac_policy.csv", "../examples/rbac_model.conf")
+
+	// Readers never see the rules of data2_admin removed without their replacement.
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			rules := [][]string{{"data2_admin", "data2", "admin"}}
+			if i%2 == 1 {
+				rules = [][]string{{"data2_admin", "data2", "read"}, {"data2_admin", "data2", "write"}}
+			}
+			_, err := e.s.UpdateFilteredPolicies(e.ctx, &pb.UpdateFilteredPoliciesRequest{EnforcerHandler: e.h,
+				NewRules: wrapRules(rules), FieldIndex: 0, FieldValues: []string{"data2_admin"}})
+			assert.NoError(t, err)
+		}
+	}()
+	for {
+		select {
+		case <-done:
+			return
+		default:
+		}
+		reply, err := e.s.GetFilteredPolicy(e.ctx, &pb.FilteredPolicyRequest{EnforcerHandler: e.h, FieldIndex: 0, FieldValues: []string{"data2_admin"}})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, reply.D2)
+	}
+}
+
+func TestUpdateGroupingPolicyAPI(t *testing.T) {
+	e := newTestEngine(t, "file", "../examples/rbac_policy.csv", "../examples/rbac_model.conf")
+
+	reply, err := e.s.UpdateGroupingPolicy(e.ctx, &pb.UpdatePolicyRequest{EnforcerHandler: e.h,
+		OldRule: []string{"alice", "data2_admin"}, NewRule: []string{"bob", "data2_admin"}})
+	assert.NoError(t, err)
+	assert.True(t, reply.Res)
+
+	testGetRoles(t, e, "alice", []string{})
+	testGetRoles(t, e, "bob", []string{"data2_admin"})
+	testEnforce(t, e, "alice", "data2", "read", false)
+	testEnforce(t, e, "bob", "data2", "read", true)
+
+	reply, err = e.s.UpdateGroupingPolicies(e.ctx, &pb.UpdatePoliciesRequest{EnforcerHandler: e.h,
+		OldRules: []*pb.PoliciesRequestRule{{Params: []string{"bob", "data2_admin"}}},
+		NewRules: []*pb.PoliciesRequestRule{{Params: []string{"alice", "data2_admin"}}}})
+	assert.NoError(t, err)
+	assert.True(t, reply.Res)
+
+	testGetRoles(t, e, "alice", []string{"data2_admin"})
+	testGetRoles(t, e, "bob", []string{})
+}
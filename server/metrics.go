@@ -0,0 +1,200 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/casbin/casbin/v2"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+const metricsNamespace = "casbin_server"
+
+// Metrics collects the Prometheus metrics of a Server: the RPCs through its interceptors,
+// and the decisions and adapter calls through hooks in the Server.
+type Metrics struct {
+	s *Server
+
+	rpcs            *prometheus.CounterVec
+	rpcDuration     *prometheus.HistogramVec
+	decisions       *prometheus.CounterVec
+	enforceDuration *prometheus.HistogramVec
+	adapterDuration *prometheus.HistogramVec
+	adapterErrors   *prometheus.CounterVec
+
+	enforcers *prometheus.Desc
+	adapters  *prometheus.Desc
+	policies  *prometheus.Desc
+}
+
+// NewMetrics creates the metrics of s, registers them with registerer and starts collecting them.
+func NewMetrics(s *Server, registerer prometheus.Registerer) (*Metrics, error) {
+	m := &Metrics{
+		s: s,
+		rpcs: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "rpcs_total",
+			Help:      "Number of RPCs handled, by method and status code.",
+		}, []string{"method", "code"}),
+		rpcDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Name:      "rpc_duration_seconds",
+			Help:      "Time taken to handle the RPCs, by method.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method"}),
+		decisions: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "enforce_decisions_total",
+			Help:      "Number of enforcement decisions, by enforcer handle and result (allow, deny or error).",
+		}, []string{"handle", "result"}),
+		enforceDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Name:      "enforce_duration_seconds",
+			Help:      "Time taken by the enforcer to decide a request, by enforcer handle.",
+			Buckets:   []float64{.00001, .000025, .00005, .0001, .00025, .0005, .001, .0025, .005, .01, .025, .05, .1},
+		}, []string{"handle"}),
+		adapterDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Name:      "adapter_duration_seconds",
+			Help:      "Time taken by the calls to the adapters, by operation: connect, load, save or close.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"operation"}),
+		adapterErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "adapter_errors_total",
+			Help:      "Number of failed calls to the adapters, by operation.",
+		}, []string{"operation"}),
+		enforcers: prometheus.NewDesc(metricsNamespace+"_enforcers", "Number of live enforcers.", nil, nil),
+		adapters:  prometheus.NewDesc(metricsNamespace+"_adapters", "Number of live adapters.", nil, nil),
+		policies: prometheus.NewDesc(metricsNamespace+"_policy_rules",
+			"Number of rules in the policy of each enforcer, by enforcer handle and ptype.", []string{"handle", "ptype"}, nil),
+	}
+
+	for _, c := range []prometheus.Collector{m.rpcs, m.rpcDuration, m.decisions, m.enforceDuration, m.adapterDuration, m.adapterErrors, m} {
+		if err := registerer.Register(c); err != nil {
+			return nil, err
+		}
+	}
+	s.metrics = m
+	return m, nil
+}
+
+// Describe implements prometheus.Collector for the metrics read from the state of the server.
+func (m *Metrics) Describe(ch chan<- *prometheus.Desc) {
+	ch <- m.enforcers
+	ch <- m.adapters
+	ch <- m.policies
+}
+
+// Collect implements prometheus.Collector for the metrics read from the state of the server.
+func (m *Metrics) Collect(ch chan<- prometheus.Metric) {
+	m.s.muA.RLock()
+	adapters := len(m.s.adapterMap)
+	m.s.muA.RUnlock()
+	ch <- prometheus.MustNewConstMetric(m.adapters, prometheus.GaugeValue, float64(adapters))
+
+	m.s.muE.RLock()
+	enforcers := make(map[int]*casbin.SyncedEnforcer, len(m.s.enforcerMap))
+	handles := make([]int, 0, len(m.s.enforcerMap))
+	for h, e := range m.s.enforcerMap {
+		enforcers[h] = e
+		handles = append(handles, h)
+	}
+	m.s.muE.RUnlock()
+	ch <- prometheus.MustNewConstMetric(m.enforcers, prometheus.GaugeValue, float64(len(handles)))
+
+	sort.Ints(handles)
+	for _, h := range handles {
+		e := enforcers[h]
+		e.GetLock().RLock()
+		for _, sec := range []string{"p", "g"} {
+			for ptype, assertion := range e.GetModel()[sec] {
+				ch <- prometheus.MustNewConstMetric(m.policies, prometheus.GaugeValue, float64(len(assertion.Policy)), strconv.Itoa(h), ptype)
+			}
+		}
+		e.GetLock().RUnlock()
+	}
+}
+
+func rpcMethod(fullMethod string) string {
+	return fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+}
+
+func (m *Metrics) observeRPC(fullMethod string, err error, d time.Duration) {
+	method := rpcMethod(fullMethod)
+	m.rpcs.WithLabelValues(method, status.Code(err).String()).Inc()
+	m.rpcDuration.WithLabelValues(method).Observe(d.Seconds())
+}
+
+// UnaryInterceptor counts and times unary RPCs.
+func (m *Metrics) UnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	m.observeRPC(info.FullMethod, err, time.Since(start))
+	return resp, err
+}
+
+// StreamInterceptor counts and times streaming RPCs, which are observed when they end.
+func (m *Metrics) StreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	start := time.Now()
+	err := handler(srv, ss)
+	m.observeRPC(info.FullMethod, err, time.Since(start))
+	return err
+}
+
+// observeEnforce records a decision of the enforcer behind handle. m may be nil.
+func (m *Metrics) observeEnforce(handle int, res bool, err error, d time.Duration) {
+	if m == nil {
+		return
+	}
+	h := strconv.Itoa(handle)
+	result := "deny"
+	switch {
+	case err != nil:
+		result = "error"
+	case res:
+		result = "allow"
+	}
+	m.decisions.WithLabelValues(h, result).Inc()
+	m.enforceDuration.WithLabelValues(h).Observe(d.Seconds())
+}
+
+// observeAdapter records a call to an adapter. m may be nil.
+func (m *Metrics) observeAdapter(operation string, err error, d time.Duration) {
+	if m == nil {
+		return
+	}
+	m.adapterDuration.WithLabelValues(operation).Observe(d.Seconds())
+	if err != nil {
+		m.adapterErrors.WithLabelValues(operation).Inc()
+	}
+}
+
+// forgetEnforcer drops the metrics of a freed enforcer, as its handle is never reused. m may be nil.
+func (m *Metrics) forgetEnforcer(handle int) {
+	if m == nil {
+		return
+	}
+	labels := prometheus.Labels{"handle": strconv.Itoa(handle)}
+	m.decisions.DeletePartialMatch(labels)
+	m.enforceDuration.DeletePartialMatch(labels)
+}
@@ -0,0 +1,85 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	pb "github.com/casbin/casbin-server/proto"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+func TestMetrics(t *testing.T) {
+	e := newTestEngine(t, "file", "../examples/rbac_policy.csv", "../examples/rbac_model.conf")
+	registry := prometheus.NewRegistry()
+	m, err := NewMetrics(e.s, registry)
+	assert.NoError(t, err)
+
+	info := &grpc.UnaryServerInfo{FullMethod: "/proto.Casbin/Enforce"}
+	enforce := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return e.s.Enforce(ctx, req.(*pb.EnforceRequest))
+	}
+	for _, params := range [][]string{{"alice", "data1", "read"}, {"alice", "data2", "read"}, {"bob", "data1", "read"}} {
+		_, err = m.UnaryInterceptor(e.ctx, &pb.EnforceRequest{EnforcerHandler: e.h, Params: params}, info, enforce)
+		assert.NoError(t, err)
+	}
+	_, err = m.UnaryInterceptor(e.ctx, &pb.EnforceRequest{EnforcerHandler: 42}, info, enforce)
+	assert.Error(t, err)
+
+	assert.Equal(t, 3.0, testutil.ToFloat64(m.rpcs.WithLabelValues("Enforce", "OK")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(m.rpcs.WithLabelValues("Enforce", "NotFound")))
+	assert.Equal(t, 2.0, testutil.ToFloat64(m.decisions.WithLabelValues("0", "allow")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(m.decisions.WithLabelValues("0", "deny")))
+
+	_, err = e.s.BatchEnforce(e.ctx, &pb.BatchEnforceRequest{EnforcerHandler: e.h, Requests: []*pb.BatchEnforceRequestRequest{
+		{Params: []string{"alice", "data1", "read"}},
+		{Params: []string{"bob", "data2", "read"}},
+	}})
+	assert.NoError(t, err)
+	assert.Equal(t, 3.0, testutil.ToFloat64(m.decisions.WithLabelValues("0", "allow")))
+	assert.Equal(t, 2.0, testutil.ToFloat64(m.decisions.WithLabelValues("0", "deny")))
+
+	_, err = e.s.LoadPolicy(e.ctx, &pb.EmptyRequest{Handler: e.h})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, testutil.CollectAndCount(m.adapterDuration))
+	assert.Equal(t, 0, testutil.CollectAndCount(m.adapterErrors))
+
+	_, err = e.s.AddGroupingPolicy(e.ctx, &pb.PolicyRequest{EnforcerHandler: e.h, Params: []string{"eve", "data2_admin"}})
+	assert.NoError(t, err)
+	err = testutil.GatherAndCompare(registry, strings.NewReader(`
+# HELP casbin_server_adapters Number of live adapters.
+# TYPE casbin_server_adapters gauge
+casbin_server_adapters 1
+# HELP casbin_server_enforcers Number of live enforcers.
+# TYPE casbin_server_enforcers gauge
+casbin_server_enforcers 1
+# HELP casbin_server_policy_rules Number of rules in the policy of each enforcer, by enforcer handle and ptype.
+# TYPE casbin_server_policy_rules gauge
+casbin_server_policy_rules{handle="0",ptype="g"} 4
+casbin_server_policy_rules{handle="0",ptype="p"} 6
+`), "casbin_server_adapters", "casbin_server_enforcers", "casbin_server_policy_rules")
+	assert.NoError(t, err)
+
+	// The metrics of a freed enforcer are dropped.
+	_, err = e.s.FreeEnforcer(e.ctx, &pb.EmptyRequest{Handler: e.h})
+	assert.NoError(t, err)
+	assert.Equal(t, 0, testutil.CollectAndCount(m.decisions))
+	assert.Equal(t, 0, testutil.CollectAndCount(m, "casbin_server_policy_rules"))
+}
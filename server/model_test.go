@@ -21,6 +21,9 @@ import (
 
 	pb "github.com/casbin/casbin-server/proto"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 func testEnforce(t *testing.T, e *testEngine, sub string, obj string, act string, res bool) {
@@ -124,3 +127,213 @@ func testModel(t *testing.T, s *Server, enforcerHandler int32, sub string, obj s
 		t.Errorf("%s, %v, %s: %t, supposed to be %t", sub, obj, act, !res, res)
 	}
 }
+
+func TestBatchEnforce(t *testing.T) {
+	e := newTestEngine(t, "file", "../examples/rbac_policy.csv", "../examples/rbac_model.conf")
+
+	reply, err := e.s.BatchEnforce(e.ctx, &pb.BatchEnforceRequest{
+		EnforcerHandler: e.h,
+		Requests: []*pb.BatchEnforceRequestRequest{
+			{Params: []string{"alice", "data1", "read"}},
+			{Params: []string{"alice", "data1", "write"}},
+			{Params: []string{"alice", "data2", "read"}},
+			{Params: []string{"bob", "data2", "write"}},
+			{Params: []string{"bob", "data1", "read"}},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []bool{true, false, true, true, false}, reply.Res)
+
+	_, err = e.s.BatchEnforce(e.ctx, &pb.BatchEnforceRequest{EnforcerHandler: e.h + 1})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestBatchEnforceABAC(t *testing.T) {
+	s := NewServer()
+	ctx := context.Background()
+
+	modelText, err := os.ReadFile("../examples/abac_model.conf")
+	if err != nil {
+		t.Error(err)
+	}
+
+	resp, err := s.NewEnforcer(ctx, &pb.NewEnforcerRequest{ModelText: string(modelText), AdapterHandle: -1})
+	if err != nil {
+		t.Error(err)
+	}
+	type ABACModel struct {
+		Name  string
+		Owner string
+	}
+
+	data1, _ := MakeABAC(ABACModel{Name: "data1", Owner: "alice"})
+	data2, _ := MakeABAC(ABACModel{Name: "data2", Owner: "bob"})
+
+	reply, err := s.BatchEnforce(ctx, &pb.BatchEnforceRequest{
+		EnforcerHandler: resp.Handler,
+		Requests: []*pb.BatchEnforceRequestRequest{
+			{Params: []string{"alice", data1, "read"}},
+			{Params: []string{"alice", data2, "read"}},
+			{Params: []string{"bob", data2, "write"}},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []bool{true, false, true}, reply.Res)
+}
+
+func TestEnforceEx(t *testing.T) {
+	e := newTestEngine(t, "file", "../examples/rbac_policy.csv", "../examples/rbac_model.conf")
+
+	reply, err := e.s.EnforceEx(e.ctx, &pb.EnforceRequest{EnforcerHandler: e.h, Params: []string{"alice", "data2", "read"}})
+	assert.NoError(t, err)
+	assert.True(t, reply.Res)
+	assert.Equal(t, []string{"data2_admin", "data2", "read"}, reply.Explain)
+
+	reply, err = e.s.EnforceEx(e.ctx, &pb.EnforceRequest{EnforcerHandler: e.h, Params: []string{"alice", "data1", "write"}})
+	assert.NoError(t, err)
+	assert.False(t, reply.Res)
+	assert.Empty(t, reply.Explain)
+}
+
+func TestEnforceExABAC(t *testing.T) {
+	s := NewServer()
+	ctx := context.Background()
+
+	modelText, err := os.ReadFile("../examples/abac_model.conf")
+	if err != nil {
+		t.Error(err)
+	}
+
+	resp, err := s.NewEnforcer(ctx, &pb.NewEnforcerRequest{ModelText: string(modelText), AdapterHandle: -1})
+	if err != nil {
+		t.Error(err)
+	}
+	type ABACModel struct {
+		Name  string
+		Owner string
+	}
+
+	data1, _ := MakeABAC(ABACModel{Name: "data1", Owner: "alice"})
+
+	reply, err := s.EnforceEx(ctx, &pb.EnforceRequest{EnforcerHandler: resp.Handler, Params: []string{"alice", data1, "read"}})
+	assert.NoError(t, err)
+	assert.True(t, reply.Res)
+
+	reply, err = s.EnforceEx(ctx, &pb.EnforceRequest{EnforcerHandler: resp.Handler, Params: []string{"bob", data1, "read"}})
+	assert.NoError(t, err)
+	assert.False(t, reply.Res)
+}
+
+func TestMalformedABAC(t *testing.T) {
+	s := NewServer()
+	ctx := context.Background()
+
+	modelText, err := os.ReadFile("../examples/abac_model.conf")
+	if err != nil {
+		t.Error(err)
+	}
+
+	resp, err := s.NewEnforcer(ctx, &pb.NewEnforcerRequest{ModelText: string(modelText), AdapterHandle: -1})
+	if err != nil {
+		t.Error(err)
+	}
+
+	_, err = s.Enforce(ctx, &pb.EnforceRequest{EnforcerHandler: resp.Handler, Params: []string{"alice", "ABAC::{not json", "read"}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = s.BatchEnforce(ctx, &pb.BatchEnforceRequest{
+		EnforcerHandler: resp.Handler,
+		Requests:        []*pb.BatchEnforceRequestRequest{{Params: []string{"alice", "ABAC::[]", "read"}}},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestTypedABACModel(t *testing.T) {
+	s := NewServer()
+	ctx := context.Background()
+
+	modelText := `
+[request_definition]
+r = sub, obj, act
+
+[policy_definition]
+p = sub, obj, act
+
+[policy_effect]
+e = some(where (p.eft == allow))
+
+[matchers]
+m = r.sub.Age > 18 && r.sub.Verified == true && r.obj.Owner.Dept == r.sub.Dept && r.obj.Field11 == "k"
+`
+	resp, err := s.NewEnforcer(ctx, &pb.NewEnforcerRequest{ModelText: modelText, AdapterHandle: -1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type Owner struct {
+		Name string
+		Dept string
+	}
+	type Object struct {
+		Owner   Owner
+		Tags    []string
+		Field1  string
+		Field2  string
+		Field3  string
+		Field4  string
+		Field5  string
+		Field6  string
+		Field7  string
+		Field8  string
+		Field9  string
+		Field10 string
+		Field11 string
+	}
+
+	obj, _ := MakeABAC(Object{Owner: Owner{Name: "alice", Dept: "billing"}, Tags: []string{"a"}, Field11: "k"})
+	// Lower-case keys, as sent by most non-Go clients, are reachable with an upper-case first letter.
+	adult, _ := MakeABAC(map[string]interface{}{"age": 30, "verified": true, "dept": "billing"})
+	minor, _ := MakeABAC(map[string]interface{}{"age": 17, "verified": true, "dept": "billing"})
+	unverified, _ := MakeABAC(map[string]interface{}{"age": 30, "verified": false, "dept": "billing"})
+	otherDept, _ := MakeABAC(map[string]interface{}{"age": 30, "verified": true, "dept": "sales"})
+
+	testModel(t, s, resp.Handler, adult, obj, "read", true)
+	testModel(t, s, resp.Handler, minor, obj, "read", false)
+	testModel(t, s, resp.Handler, unverified, obj, "read", false)
+	testModel(t, s, resp.Handler, otherDept, obj, "read", false)
+}
+
+func TestStructParams(t *testing.T) {
+	s := NewServer()
+	ctx := context.Background()
+
+	modelText, err := os.ReadFile("../examples/abac_model.conf")
+	if err != nil {
+		t.Error(err)
+	}
+
+	resp, err := s.NewEnforcer(ctx, &pb.NewEnforcerRequest{ModelText: string(modelText), AdapterHandle: -1})
+	if err != nil {
+		t.Error(err)
+	}
+
+	data1, err := structpb.NewStruct(map[string]interface{}{"Name": "data1", "Owner": "alice"})
+	assert.NoError(t, err)
+
+	reply, err := s.Enforce(ctx, &pb.EnforceRequest{
+		EnforcerHandler: resp.Handler,
+		Values:          []*structpb.Value{structpb.NewStringValue("alice"), structpb.NewStructValue(data1), structpb.NewStringValue("read")},
+	})
+	assert.NoError(t, err)
+	assert.True(t, reply.Res)
+
+	batch, err := s.BatchEnforce(ctx, &pb.BatchEnforceRequest{
+		EnforcerHandler: resp.Handler,
+		Requests: []*pb.BatchEnforceRequestRequest{
+			{Values: []*structpb.Value{structpb.NewStringValue("alice"), structpb.NewStructValue(data1), structpb.NewStringValue("write")}},
+			{Values: []*structpb.Value{structpb.NewStringValue("bob"), structpb.NewStructValue(data1), structpb.NewStringValue("write")}},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []bool{true, false}, batch.Res)
+}
@@ -16,11 +16,25 @@ package server
 
 import (
 	"context"
-	"errors"
 
 	pb "github.com/casbin/casbin-server/proto"
+	"github.com/casbin/casbin/v2"
+	"github.com/casbin/casbin/v2/constant"
+	"github.com/casbin/casbin/v2/rbac"
 )
 
+// getRoleManager gets the role manager of the "g" role definition of e.
+func (s *Server) getRoleManager(e *casbin.SyncedEnforcer) (rbac.RoleManager, error) {
+	e.GetLock().RLock()
+	assertion, err := e.GetModel().GetAssertion("g", "g")
+	e.GetLock().RUnlock()
+	if err != nil || assertion.RM == nil {
+		return nil, errRoleManagerNil
+	}
+
+	return assertion.RM, nil
+}
+
 // GetDomains gets the domains that a user has.
 func (s *Server) GetDomains(ctx context.Context, in *pb.UserRoleRequest) (*pb.ArrayReply, error) {
 	e, err := s.getEnforcer(int(in.EnforcerHandler))
@@ -28,9 +42,9 @@ func (s *Server) GetDomains(ctx context.Context, in *pb.UserRoleRequest) (*pb.Ar
 		return &pb.ArrayReply{}, err
 	}
 
-	rm := e.GetModel()["g"]["g"].RM
-	if rm == nil {
-		return nil, errors.New("RoleManager is nil")
+	rm, err := s.getRoleManager(e)
+	if err != nil {
+		return nil, err
 	}
 
 	res, _ := rm.GetDomains(in.User)
@@ -45,9 +59,9 @@ func (s *Server) GetRolesForUser(ctx context.Context, in *pb.UserRoleRequest) (*
 		return &pb.ArrayReply{}, err
 	}
 
-	rm := e.GetModel()["g"]["g"].RM
-	if rm == nil {
-		return nil, errors.New("RoleManager is nil")
+	rm, err := s.getRoleManager(e)
+	if err != nil {
+		return nil, err
 	}
 
 	res, _ := rm.GetRoles(in.User, in.Domain...)
@@ -62,7 +76,7 @@ func (s *Server) GetImplicitRolesForUser(ctx context.Context, in *pb.UserRoleReq
 		return &pb.ArrayReply{}, err
 	}
 	res, err := e.GetImplicitRolesForUser(in.User)
-	return &pb.ArrayReply{Array: res}, err
+	return &pb.ArrayReply{Array: res}, invalidArgumentError(err)
 }
 
 // GetUsersForRole gets the users that have a role.
@@ -72,9 +86,9 @@ func (s *Server) GetUsersForRole(ctx context.Context, in *pb.UserRoleRequest) (*
 		return &pb.ArrayReply{}, err
 	}
 
-	rm := e.GetModel()["g"]["g"].RM
-	if rm == nil {
-		return nil, errors.New("RoleManager is nil")
+	rm, err := s.getRoleManager(e)
+	if err != nil {
+		return nil, err
 	}
 
 	res, _ := rm.GetUsers(in.Role)
@@ -91,7 +105,7 @@ func (s *Server) HasRoleForUser(ctx context.Context, in *pb.UserRoleRequest) (*p
 
 	roles, err := e.GetRolesForUser(in.User)
 	if err != nil {
-		return &pb.BoolReply{}, err
+		return &pb.BoolReply{}, invalidArgumentError(err)
 	}
 
 	for _, r := range roles {
@@ -111,8 +125,11 @@ func (s *Server) AddRoleForUser(ctx context.Context, in *pb.UserRoleRequest) (*p
 		return &pb.BoolReply{}, err
 	}
 
-	ruleAdded, err := e.AddGroupingPolicy(in.User, in.Role)
-	return &pb.BoolReply{Res: ruleAdded}, err
+	ruleAdded, isNew, err := addRule(e, "g", "g", []string{in.User, in.Role})
+	if isNew && err == nil {
+		s.notifyPolicy(in.EnforcerHandler, pb.PolicyEvent_ADD, "g", [][]string{{in.User, in.Role}})
+	}
+	return &pb.BoolReply{Res: ruleAdded}, policyError(e, "g", "g", err)
 }
 
 // DeleteRoleForUser deletes a role for a user.
@@ -124,7 +141,10 @@ func (s *Server) DeleteRoleForUser(ctx context.Context, in *pb.UserRoleRequest)
 	}
 
 	ruleRemoved, err := e.RemoveGroupingPolicy(in.User, in.Role)
-	return &pb.BoolReply{Res: ruleRemoved}, err
+	if ruleRemoved && err == nil {
+		s.notifyPolicy(in.EnforcerHandler, pb.PolicyEvent_REMOVE, "g", [][]string{{in.User, in.Role}})
+	}
+	return &pb.BoolReply{Res: ruleRemoved}, policyError(e, "g", "g", err)
 }
 
 // DeleteRolesForUser deletes all roles for a user.
@@ -135,8 +155,11 @@ func (s *Server) DeleteRolesForUser(ctx context.Context, in *pb.UserRoleRequest)
 		return &pb.BoolReply{}, err
 	}
 
-	ruleRemoved, err := e.RemoveFilteredGroupingPolicy(0, in.User)
-	return &pb.BoolReply{Res: ruleRemoved}, err
+	rules, err := removeFilteredRules(e, "g", "g", 0, in.User)
+	if len(rules) > 0 && err == nil {
+		s.notifyPolicy(in.EnforcerHandler, pb.PolicyEvent_REMOVE, "g", rules)
+	}
+	return &pb.BoolReply{Res: len(rules) > 0}, policyError(e, "g", "g", err)
 }
 
 // DeleteUser deletes a user.
@@ -147,8 +170,11 @@ func (s *Server) DeleteUser(ctx context.Context, in *pb.UserRoleRequest) (*pb.Bo
 		return &pb.BoolReply{}, err
 	}
 
-	ruleRemoved, err := e.RemoveFilteredGroupingPolicy(0, in.User)
-	return &pb.BoolReply{Res: ruleRemoved}, err
+	rules, err := removeFilteredRules(e, "g", "g", 0, in.User)
+	if len(rules) > 0 && err == nil {
+		s.notifyPolicy(in.EnforcerHandler, pb.PolicyEvent_REMOVE, "g", rules)
+	}
+	return &pb.BoolReply{Res: len(rules) > 0}, policyError(e, "g", "g", err)
 }
 
 // DeleteRole deletes a role.
@@ -158,8 +184,33 @@ func (s *Server) DeleteRole(ctx context.Context, in *pb.UserRoleRequest) (*pb.Em
 		return &pb.EmptyReply{}, err
 	}
 
-	_, err = e.DeleteRole(in.Role)
-	return &pb.EmptyReply{}, err
+	groupingRules, rules, err := deleteRole(e, in.Role)
+	if err == nil {
+		s.notifyPolicy(in.EnforcerHandler, pb.PolicyEvent_REMOVE, "g", groupingRules)
+		s.notifyPolicy(in.EnforcerHandler, pb.PolicyEvent_REMOVE, "p", rules)
+	}
+	return &pb.EmptyReply{}, policyError(e, "g", "g", err)
+}
+
+// deleteRole removes the grouping rules and the rules of role from e like casbin's
+// DeleteRole, and returns the rules removed, all while holding the lock of e.
+func deleteRole(e *casbin.SyncedEnforcer, role string) (groupingRules [][]string, rules [][]string, err error) {
+	e.GetLock().Lock()
+	defer e.GetLock().Unlock()
+
+	for _, fieldIndex := range []int{0, 1} {
+		removed, err := removeFilteredRulesLocked(e, "g", "g", fieldIndex, role)
+		groupingRules = append(groupingRules, removed...)
+		if err != nil {
+			return groupingRules, nil, err
+		}
+	}
+	subIndex, err := e.Enforcer.GetFieldIndex("p", constant.SubjectIndex)
+	if err != nil {
+		return groupingRules, nil, err
+	}
+	rules, err = removeFilteredRulesLocked(e, "p", "p", subIndex, role)
+	return groupingRules, rules, err
 }
 
 // DeletePermission deletes a permission.
@@ -170,8 +221,11 @@ func (s *Server) DeletePermission(ctx context.Context, in *pb.PermissionRequest)
 		return &pb.BoolReply{}, err
 	}
 
-	ruleRemoved, err := e.RemoveFilteredPolicy(1, in.Permissions...)
-	return &pb.BoolReply{Res: ruleRemoved}, err
+	rules, err := removeFilteredRules(e, "p", "p", 1, in.Permissions...)
+	if len(rules) > 0 && err == nil {
+		s.notifyPolicy(in.EnforcerHandler, pb.PolicyEvent_REMOVE, "p", rules)
+	}
+	return &pb.BoolReply{Res: len(rules) > 0}, policyError(e, "p", "p", err)
 }
 
 // AddPermissionForUser adds a permission for a user or role.
@@ -182,8 +236,12 @@ func (s *Server) AddPermissionForUser(ctx context.Context, in *pb.PermissionRequ
 		return &pb.BoolReply{}, err
 	}
 
-	ruleAdded, err := e.AddPolicy(s.convertPermissions(in.User, in.Permissions...)...)
-	return &pb.BoolReply{Res: ruleAdded}, err
+	rule := append([]string{in.User}, in.Permissions...)
+	ruleAdded, isNew, err := addRule(e, "p", "p", rule)
+	if isNew && err == nil {
+		s.notifyPolicy(in.EnforcerHandler, pb.PolicyEvent_ADD, "p", [][]string{rule})
+	}
+	return &pb.BoolReply{Res: ruleAdded}, policyError(e, "p", "p", err)
 }
 
 // DeletePermissionForUser deletes a permission for a user or role.
@@ -195,7 +253,10 @@ func (s *Server) DeletePermissionForUser(ctx context.Context, in *pb.PermissionR
 	}
 
 	ruleRemoved, err := e.RemovePolicy(s.convertPermissions(in.User, in.Permissions...)...)
-	return &pb.BoolReply{Res: ruleRemoved}, err
+	if ruleRemoved && err == nil {
+		s.notifyPolicy(in.EnforcerHandler, pb.PolicyEvent_REMOVE, "p", [][]string{append([]string{in.User}, in.Permissions...)})
+	}
+	return &pb.BoolReply{Res: ruleRemoved}, policyError(e, "p", "p", err)
 }
 
 // DeletePermissionsForUser deletes permissions for a user or role.
@@ -206,8 +267,11 @@ func (s *Server) DeletePermissionsForUser(ctx context.Context, in *pb.Permission
 		return &pb.BoolReply{}, err
 	}
 
-	ruleRemoved, err := e.RemoveFilteredPolicy(0, in.User)
-	return &pb.BoolReply{Res: ruleRemoved}, err
+	rules, err := removeFilteredRules(e, "p", "p", 0, in.User)
+	if len(rules) > 0 && err == nil {
+		s.notifyPolicy(in.EnforcerHandler, pb.PolicyEvent_REMOVE, "p", rules)
+	}
+	return &pb.BoolReply{Res: len(rules) > 0}, policyError(e, "p", "p", err)
 }
 
 // GetPermissionsForUser gets permissions for a user or role.
@@ -219,7 +283,7 @@ func (s *Server) GetPermissionsForUser(ctx context.Context, in *pb.PermissionReq
 
 	filteredPolicy, err := e.GetFilteredPolicy(0, in.User)
 	if err != nil {
-		return &pb.Array2DReply{}, err
+		return &pb.Array2DReply{}, invalidArgumentError(err)
 	}
 
 	return s.wrapPlainPolicy(filteredPolicy), nil
@@ -232,7 +296,7 @@ func (s *Server) GetImplicitPermissionsForUser(ctx context.Context, in *pb.Permi
 		return &pb.Array2DReply{}, err
 	}
 	resp, err := e.GetImplicitPermissionsForUser(in.User, in.Domain...)
-	return s.wrapPlainPolicy(resp), err
+	return s.wrapPlainPolicy(resp), invalidArgumentError(err)
 }
 
 // HasPermissionForUser determines whether a user has a permission.
@@ -244,7 +308,7 @@ func (s *Server) HasPermissionForUser(ctx context.Context, in *pb.PermissionRequ
 
 	hasPolicy, err := e.HasPolicy(s.convertPermissions(in.User, in.Permissions...)...)
 	if err != nil {
-		return &pb.BoolReply{}, err
+		return &pb.BoolReply{}, invalidArgumentError(err)
 	}
 
 	return &pb.BoolReply{Res: hasPolicy}, nil
@@ -0,0 +1,114 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"os"
+	"sync"
+	"time"
+)
+
+// ConfigReloader creates the adapters and enforcers of a BootstrapConfig file, and
+// recreates those that changed when the file or the model files it references are
+// modified, so the server does not need to be restarted and clients keep their handles.
+type ConfigReloader struct {
+	path string
+	b    *bootstrap
+
+	mu      sync.Mutex
+	modTime map[string]time.Time
+}
+
+// NewConfigReloader creates a reloader of the BootstrapConfig file at path for s.
+// Nothing is created until Reload is called.
+func NewConfigReloader(s *Server, path string) *ConfigReloader {
+	return &ConfigReloader{path: path, b: newBootstrap(s), modTime: map[string]time.Time{}}
+}
+
+func fileModTime(path string) time.Time {
+	info, err := os.Stat(path)
+	if err != nil {
+		return time.Time{}
+	}
+	return info.ModTime()
+}
+
+// Reload reads the config file and applies it. If it fails, e.g. because a model does
+// not parse, the adapters and enforcers are left as they were and the error is returned.
+func (r *ConfigReloader) Reload(ctx context.Context) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// The files are stat'ed before they are read, so that a change made while
+	// reloading triggers another reload.
+	configTime := fileModTime(r.path)
+	config, err := LoadBootstrapConfig(r.path)
+	if err != nil {
+		r.modTime[r.path] = configTime
+		return err
+	}
+
+	modTime := map[string]time.Time{r.path: configTime}
+	for _, e := range config.Enforcers {
+		if e.Model != "" {
+			modTime[e.Model] = fileModTime(e.Model)
+		}
+	}
+	r.modTime = modTime
+	return redactError(r.b.apply(ctx, config))
+}
+
+func (r *ConfigReloader) changed() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for file, t := range r.modTime {
+		if !fileModTime(file).Equal(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// Watch reloads the config when a signal is received on signals, e.g. SIGHUP, or when
+// one of the files is modified, which is checked every interval unless it is 0. It
+// returns when ctx is done. Failed reloads are logged.
+func (r *ConfigReloader) Watch(ctx context.Context, interval time.Duration, signals <-chan os.Signal) {
+	var tick <-chan time.Time
+	if interval > 0 {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-signals:
+		case <-tick:
+			if !r.changed() {
+				continue
+			}
+		}
+
+		if err := r.Reload(ctx); err != nil {
+			logf("failed to reload config %s, keeping the previous enforcers: %v", r.path, err)
+		} else {
+			logf("reloaded config %s", r.path)
+		}
+	}
+}
@@ -0,0 +1,168 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	pb "github.com/casbin/casbin-server/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+// withoutRoles is the rbac model with a matcher ignoring the roles.
+func withoutRoles(t *testing.T) string {
+	data, err := os.ReadFile("../examples/rbac_model.conf")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return strings.Replace(string(data), "g(r.sub, p.sub)", "r.sub == p.sub", 1)
+}
+
+type reloadTest struct {
+	t     *testing.T
+	dir   string
+	s     *Server
+	r     *ConfigReloader
+	model string
+}
+
+func newReloadTest(t *testing.T) *reloadTest {
+	dir := t.TempDir()
+	rt := &reloadTest{t: t, dir: dir, s: NewServer(), model: filepath.Join(dir, "model.conf")}
+	modelText, err := os.ReadFile("../examples/rbac_model.conf")
+	assert.NoError(t, err)
+	rt.writeModel(string(modelText))
+	rt.writeConfig("authz", "")
+	rt.r = NewConfigReloader(rt.s, filepath.Join(dir, "config.yaml"))
+	assert.NoError(t, rt.r.Reload(context.Background()))
+	return rt
+}
+
+func (rt *reloadTest) writeModel(text string) {
+	assert.NoError(rt.t, os.WriteFile(rt.model, []byte(text), 0600))
+}
+
+func (rt *reloadTest) writeConfig(name string, extra string) {
+	config := `adapters:
+  policy:
+    driver: file
+    connection: ../examples/rbac_policy.csv
+enforcers:
+  ` + name + `:
+    model: ` + rt.model + `
+    adapter: policy
+` + extra
+	assert.NoError(rt.t, os.WriteFile(filepath.Join(rt.dir, "config.yaml"), []byte(config), 0600))
+}
+
+func (rt *reloadTest) handle(name string) int32 {
+	h, err := rt.s.GetEnforcerByName(context.Background(), &pb.EnforcerNameRequest{Name: name})
+	assert.NoError(rt.t, err)
+	return h.Handler
+}
+
+func (rt *reloadTest) enforce(name string, params ...string) bool {
+	res, err := rt.s.Enforce(context.Background(), &pb.EnforceRequest{EnforcerHandler: rt.handle(name), Params: params})
+	assert.NoError(rt.t, err)
+	return res.Res
+}
+
+func TestReload(t *testing.T) {
+	rt := newReloadTest(t)
+	h := rt.handle("authz")
+	assert.True(t, rt.enforce("authz", "alice", "data2", "read"))
+
+	// Nothing is recreated if nothing changed.
+	e, _ := rt.s.getEnforcer(int(h))
+	assert.NoError(t, rt.r.Reload(context.Background()))
+	e2, _ := rt.s.getEnforcer(int(h))
+	assert.Same(t, e, e2)
+
+	w, cancel, err := rt.s.watchPolicy(int(h))
+	assert.NoError(t, err)
+	defer cancel()
+
+	// A changed model is swapped in under the same handle.
+	rt.writeModel(withoutRoles(t))
+	assert.NoError(t, rt.r.Reload(context.Background()))
+	assert.Equal(t, h, rt.handle("authz"))
+	assert.False(t, rt.enforce("authz", "alice", "data2", "read"))
+	assert.True(t, rt.enforce("authz", "alice", "data1", "read"))
+	assert.Equal(t, pb.PolicyEvent_LOAD, (<-w.events).EventType)
+
+	// A model that does not parse is rolled back.
+	e, _ = rt.s.getEnforcer(int(h))
+	rt.writeModel("[request_definition]\nr = sub, obj, act\n\n[matchers]\nm = r.sub ==")
+	assert.Error(t, rt.r.Reload(context.Background()))
+	e2, _ = rt.s.getEnforcer(int(h))
+	assert.Same(t, e, e2)
+	assert.True(t, rt.enforce("authz", "alice", "data1", "read"))
+
+	// So is a config that does not parse.
+	assert.NoError(t, os.WriteFile(filepath.Join(rt.dir, "config.yaml"), []byte("enforcers: [a"), 0600))
+	assert.Error(t, rt.r.Reload(context.Background()))
+	assert.Equal(t, h, rt.handle("authz"))
+
+	// Renaming an enforcer frees the previous one and creates another.
+	rt.writeModel(withoutRoles(t))
+	rt.writeConfig("renamed", "")
+	assert.NoError(t, rt.r.Reload(context.Background()))
+	_, err = rt.s.getEnforcer(int(h))
+	assert.Equal(t, errEnforcerNotFound, err)
+	_, ok := <-w.events
+	assert.False(t, ok)
+	assert.Greater(t, rt.handle("renamed"), h)
+	assert.True(t, rt.enforce("renamed", "alice", "data1", "read"))
+
+	// A name taken by a client is not replaced.
+	modelText, _ := os.ReadFile(rt.model)
+	_, err = rt.s.NewEnforcer(context.Background(), &pb.NewEnforcerRequest{ModelText: string(modelText), AdapterHandle: -1, Name: "client"})
+	assert.NoError(t, err)
+	rt.writeConfig("renamed", `  client:
+    model: `+rt.model+"\n")
+	assert.Error(t, rt.r.Reload(context.Background()))
+	assert.True(t, rt.enforce("renamed", "alice", "data1", "read"))
+}
+
+func TestReloadWatch(t *testing.T) {
+	rt := newReloadTest(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	signals := make(chan os.Signal)
+	go rt.r.Watch(ctx, 10*time.Millisecond, signals)
+
+	rt.writeModel(withoutRoles(t))
+	// Make sure the modification time changes on file systems with a coarse resolution.
+	later := time.Now().Add(time.Minute)
+	assert.NoError(t, os.Chtimes(rt.model, later, later))
+	assert.Eventually(t, func() bool {
+		return !rt.enforce("authz", "alice", "data2", "read")
+	}, 5*time.Second, 10*time.Millisecond)
+
+	modelText, err := os.ReadFile("../examples/rbac_model.conf")
+	assert.NoError(t, err)
+	rt.writeModel(string(modelText))
+	assert.NoError(t, os.Chtimes(rt.model, later, later))
+	signals <- syscall.SIGHUP
+	assert.Eventually(t, func() bool {
+		return rt.enforce("authz", "alice", "data2", "read")
+	}, 5*time.Second, 10*time.Millisecond)
+}
@@ -0,0 +1,165 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// reference matches the references in the config fields: ${file:/path} to the content of
+// a file, e.g. a Docker or Kubernetes secret, and $VAR to an environment variable.
+var reference = regexp.MustCompile(`\$\{file:([^}]*)\}|\$\b(\w*)\b`)
+
+const redacted = "[REDACTED]"
+
+// secrets are the contents of the files referenced in the config files, which are
+// redacted from the errors and the logs.
+var secrets struct {
+	sync.RWMutex
+	values []string
+}
+
+func addSecret(value string) {
+	if value == "" {
+		return
+	}
+	secrets.Lock()
+	defer secrets.Unlock()
+	for _, v := range secrets.values {
+		if v == value {
+			return
+		}
+	}
+	secrets.values = append(secrets.values, value)
+	// The longest first, so that a secret containing another is redacted whole.
+	sort.Slice(secrets.values, func(i, j int) bool { return len(secrets.values[i]) > len(secrets.values[j]) })
+}
+
+// redact replaces the secrets in s.
+func redact(s string) string {
+	secrets.RLock()
+	defer secrets.RUnlock()
+	for _, v := range secrets.values {
+		s = strings.ReplaceAll(s, v, redacted)
+	}
+	return s
+}
+
+// logf logs like log.Printf with the secrets redacted, which every log of the server goes through.
+func logf(format string, v ...interface{}) {
+	log.Print(redact(fmt.Sprintf(format, v...)))
+}
+
+type redactedError struct {
+	err error
+}
+
+func (e *redactedError) Error() string {
+	return redact(e.err.Error())
+}
+
+func (e *redactedError) Unwrap() error {
+	return e.err
+}
+
+// redactError returns err with the secrets redacted from its message.
+func redactError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := err.(*redactedError); ok {
+		return err
+	}
+	return &redactedError{err: err}
+}
+
+// readSecret returns the content of a file referenced as ${file:path}, without the
+// trailing newline, and registers it to be redacted.
+func readSecret(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	value := strings.TrimRight(string(data), "\r\n")
+	addSecret(value)
+	return value, nil
+}
+
+// expandRefs replaces the ${file:path} and $VAR references in s with the content of the
+// files and the values of the environment variables.
+func expandRefs(s string) (string, error) {
+	var err error
+	expanded := reference.ReplaceAllStringFunc(s, func(ref string) string {
+		m := reference.FindStringSubmatch(ref)
+		if !strings.HasPrefix(ref, "${") {
+			return os.Getenv(m[2])
+		}
+		value, readErr := readSecret(m[1])
+		if readErr != nil && err == nil {
+			err = fmt.Errorf("failed to read secret file: %w", readErr)
+		}
+		return value
+	})
+	return expanded, err
+}
+
+// expandConfig expands the references in all the string fields of the config v points to.
+func expandConfig(v reflect.Value) error {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if !v.IsNil() {
+			return expandConfig(v.Elem())
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).IsExported() {
+				if err := expandConfig(v.Field(i)); err != nil {
+					return err
+				}
+			}
+		}
+	case reflect.Map:
+		iter := v.MapRange()
+		for iter.Next() {
+			// Map values are not addressable, so they are expanded in a copy.
+			elem := reflect.New(iter.Value().Type()).Elem()
+			elem.Set(iter.Value())
+			if err := expandConfig(elem); err != nil {
+				return err
+			}
+			v.SetMapIndex(iter.Key(), elem)
+		}
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			if err := expandConfig(v.Index(i)); err != nil {
+				return err
+			}
+		}
+	case reflect.String:
+		expanded, err := expandRefs(v.String())
+		if err != nil {
+			return err
+		}
+		v.SetString(expanded)
+	}
+	return nil
+}
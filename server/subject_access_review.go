@@ -0,0 +1,259 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+)
+
+// SubjectAccessReviewConfig maps the Kubernetes SubjectAccessReview to the params of an enforcer.
+type SubjectAccessReviewConfig struct {
+	// Enforcer is the name of the enforcer that answers the reviews. It is looked up on
+	// every review, so it can be created with NewEnforcer after the server has started.
+	Enforcer string `json:"enforcer"`
+	// Path is the HTTP path of the webhook, "/authorize" by default.
+	Path string `json:"path"`
+	// Params are the fields of the review passed to Enforce, in order:
+	//
+	//   user, uid, group, verb, apiGroup, version, resource, subresource, namespace, name
+	//   path (of a non-resource request), extra:<key>, literal:<value>
+	//
+	// With group, the review is allowed if any of the groups of the user is allowed.
+	// The default is user, namespace, resource, verb.
+	Params []string `json:"params"`
+	// Deny makes a denied review final. Otherwise, kube-apiserver asks the next authorizer.
+	Deny bool `json:"deny"`
+}
+
+var defaultSubjectAccessReviewParams = []string{"user", "namespace", "resource", "verb"}
+
+// LoadSubjectAccessReviewConfig reads a SubjectAccessReviewConfig from a JSON file.
+func LoadSubjectAccessReviewConfig(path string) (*SubjectAccessReviewConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	config := &SubjectAccessReviewConfig{}
+	if err := json.Unmarshal(data, config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// subjectAccessReview is the subset of authorization.k8s.io/v1 SubjectAccessReview used by the webhook.
+type subjectAccessReview struct {
+	APIVersion string                    `json:"apiVersion"`
+	Kind       string                    `json:"kind"`
+	Spec       subjectAccessReviewSpec   `json:"spec"`
+	Status     subjectAccessReviewStatus `json:"status"`
+}
+
+type subjectAccessReviewSpec struct {
+	ResourceAttributes    *resourceAttributes    `json:"resourceAttributes,omitempty"`
+	NonResourceAttributes *nonResourceAttributes `json:"nonResourceAttributes,omitempty"`
+	User                  string                 `json:"user,omitempty"`
+	Groups                []string               `json:"groups,omitempty"`
+	Extra                 map[string][]string    `json:"extra,omitempty"`
+	UID                   string                 `json:"uid,omitempty"`
+}
+
+type resourceAttributes struct {
+	Namespace   string `json:"namespace,omitempty"`
+	Verb        string `json:"verb,omitempty"`
+	Group       string `json:"group,omitempty"`
+	Version     string `json:"version,omitempty"`
+	Resource    string `json:"resource,omitempty"`
+	Subresource string `json:"subresource,omitempty"`
+	Name        string `json:"name,omitempty"`
+}
+
+type nonResourceAttributes struct {
+	Path string `json:"path,omitempty"`
+	Verb string `json:"verb,omitempty"`
+}
+
+type subjectAccessReviewStatus struct {
+	Allowed         bool   `json:"allowed"`
+	Denied          bool   `json:"denied,omitempty"`
+	Reason          string `json:"reason,omitempty"`
+	EvaluationError string `json:"evaluationError,omitempty"`
+}
+
+// SubjectAccessReview serves a Kubernetes authorization webhook with an enforcer of the server.
+type SubjectAccessReview struct {
+	s      *Server
+	config SubjectAccessReviewConfig
+}
+
+// NewSubjectAccessReview creates the authorization webhook of s.
+func NewSubjectAccessReview(s *Server, config *SubjectAccessReviewConfig) (*SubjectAccessReview, error) {
+	if config.Enforcer == "" {
+		return nil, errors.New("the name of the enforcer is required")
+	}
+
+	r := &SubjectAccessReview{s: s, config: *config}
+	if r.config.Path == "" {
+		r.config.Path = "/authorize"
+	}
+	if len(r.config.Params) == 0 {
+		r.config.Params = defaultSubjectAccessReviewParams
+	}
+
+	groups := 0
+	for _, param := range r.config.Params {
+		if param == "group" {
+			groups++
+		}
+		if _, err := reviewParam(&subjectAccessReviewSpec{}, param, ""); err != nil {
+			return nil, err
+		}
+	}
+	if groups > 1 {
+		return nil, errors.New("the group param can only be used once")
+	}
+	return r, nil
+}
+
+// Path returns the HTTP path of the webhook.
+func (r *SubjectAccessReview) Path() string {
+	return r.config.Path
+}
+
+func reviewParam(spec *subjectAccessReviewSpec, param string, group string) (string, error) {
+	res := spec.ResourceAttributes
+	if res == nil {
+		res = &resourceAttributes{}
+	}
+
+	switch param {
+	case "user":
+		return spec.User, nil
+	case "uid":
+		return spec.UID, nil
+	case "group":
+		return group, nil
+	case "verb":
+		if spec.NonResourceAttributes != nil {
+			return spec.NonResourceAttributes.Verb, nil
+		}
+		return res.Verb, nil
+	case "apiGroup":
+		return res.Group, nil
+	case "version":
+		return res.Version, nil
+	case "resource":
+		return res.Resource, nil
+	case "subresource":
+		return res.Subresource, nil
+	case "namespace":
+		return res.Namespace, nil
+	case "name":
+		return res.Name, nil
+	case "path":
+		if spec.NonResourceAttributes != nil {
+			return spec.NonResourceAttributes.Path, nil
+		}
+		return "", nil
+	}
+
+	switch {
+	case strings.HasPrefix(param, "extra:"):
+		return strings.Join(spec.Extra[strings.TrimPrefix(param, "extra:")], ","), nil
+	case strings.HasPrefix(param, "literal:"):
+		return strings.TrimPrefix(param, "literal:"), nil
+	}
+	return "", fmt.Errorf("unknown SubjectAccessReview param %q", param)
+}
+
+// review enforces the params of spec, once per group of the user if the group param is used.
+func (r *SubjectAccessReview) review(ctx context.Context, spec *subjectAccessReviewSpec) (bool, error) {
+	h, err := r.s.getEnforcerHandleByName(r.config.Enforcer)
+	if err != nil {
+		return false, err
+	}
+	e, err := r.s.getEnforcer(h)
+	if err != nil {
+		return false, err
+	}
+
+	groups := []string{""}
+	for _, param := range r.config.Params {
+		if param == "group" {
+			groups = spec.Groups
+		}
+	}
+
+	for _, group := range groups {
+		params := make([]interface{}, 0, len(r.config.Params))
+		for _, param := range r.config.Params {
+			value, err := reviewParam(spec, param, group)
+			if err != nil {
+				return false, err
+			}
+			params = append(params, value)
+		}
+
+		res, err := r.s.enforce(ctx, h, e, params...)
+		if err != nil || res {
+			return res, err
+		}
+	}
+	return false, nil
+}
+
+func (r *SubjectAccessReview) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var sar subjectAccessReview
+	if err := json.NewDecoder(http.MaxBytesReader(w, req.Body, maxGatewayBodySize)).Decode(&sar); err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			http.Error(w, fmt.Sprintf("request body larger than %d bytes", maxGatewayBodySize), http.StatusRequestEntityTooLarge)
+			return
+		}
+		http.Error(w, fmt.Sprintf("invalid SubjectAccessReview: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	allowed, err := r.review(req.Context(), &sar.Spec)
+	sar.Status = subjectAccessReviewStatus{Allowed: allowed}
+	switch {
+	case err != nil:
+		logf("failed to review access of %s: %v", sar.Spec.User, err)
+		sar.Status.EvaluationError = err.Error()
+	case allowed:
+		sar.Status.Reason = "allowed by casbin enforcer " + r.config.Enforcer
+	default:
+		sar.Status.Denied = r.config.Deny
+		sar.Status.Reason = "denied by casbin enforcer " + r.config.Enforcer
+	}
+
+	if sar.APIVersion == "" {
+		sar.APIVersion = "authorization.k8s.io/v1"
+	}
+	sar.Kind = "SubjectAccessReview"
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(&sar)
+}
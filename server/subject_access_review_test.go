@@ -0,0 +1,162 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	pb "github.com/casbin/casbin-server/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+const sarAlicePods = `{
+  "apiVersion": "authorization.k8s.io/v1",
+  "kind": "SubjectAccessReview",
+  "spec": {
+    "resourceAttributes": {"namespace": "dev", "verb": "list", "group": "", "resource": "pods"},
+    "user": "alice",
+    "groups": ["developers", "system:authenticated"]
+  }
+}`
+
+const sarAliceSecrets = `{
+  "apiVersion": "authorization.k8s.io/v1",
+  "kind": "SubjectAccessReview",
+  "spec": {
+    "resourceAttributes": {"namespace": "prod", "verb": "get", "resource": "secrets"},
+    "user": "alice",
+    "groups": ["developers", "system:authenticated"]
+  }
+}`
+
+const sarCIDeployments = `{
+  "apiVersion": "authorization.k8s.io/v1",
+  "kind": "SubjectAccessReview",
+  "spec": {
+    "resourceAttributes": {"namespace": "prod", "verb": "get", "group": "apps", "resource": "deployments"},
+    "user": "system:serviceaccount:ci:deployer",
+    "groups": ["system:serviceaccounts", "system:serviceaccounts:ci", "system:authenticated"]
+  }
+}`
+
+const sarHealthz = `{
+  "apiVersion": "authorization.k8s.io/v1",
+  "kind": "SubjectAccessReview",
+  "spec": {
+    "nonResourceAttributes": {"path": "/healthz", "verb": "get"},
+    "user": "bob"
+  }
+}`
+
+func newTestSubjectAccessReview(t *testing.T, config *SubjectAccessReviewConfig) *httptest.Server {
+	e := newTestEngine(t, "file", "../examples/k8s_policy.csv", "../examples/k8s_model.conf")
+	_, err := e.s.NewEnforcer(e.ctx, &pb.NewEnforcerRequest{ModelText: e.modelText, AdapterHandle: 0, Name: "kubernetes"})
+	assert.NoError(t, err)
+
+	sar, err := NewSubjectAccessReview(e.s, config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mux := http.NewServeMux()
+	mux.Handle(sar.Path(), sar)
+	ts := httptest.NewServer(mux)
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+func postReview(t *testing.T, url string, body string) subjectAccessReviewStatus {
+	t.Helper()
+	resp, err := http.Post(url, "application/json", strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var sar subjectAccessReview
+	if err := json.NewDecoder(resp.Body).Decode(&sar); err != nil {
+		t.Fatal(err)
+	}
+	assert.Equal(t, "authorization.k8s.io/v1", sar.APIVersion)
+	assert.Equal(t, "SubjectAccessReview", sar.Kind)
+	return sar.Status
+}
+
+func TestSubjectAccessReview(t *testing.T) {
+	ts := newTestSubjectAccessReview(t, &SubjectAccessReviewConfig{Enforcer: "kubernetes"})
+
+	status := postReview(t, ts.URL+"/authorize", sarAlicePods)
+	assert.True(t, status.Allowed)
+
+	status = postReview(t, ts.URL+"/authorize", sarAliceSecrets)
+	assert.False(t, status.Allowed)
+	assert.False(t, status.Denied)
+
+	status = postReview(t, ts.URL+"/authorize", sarHealthz)
+	assert.False(t, status.Allowed)
+
+	resp, err := http.Post(ts.URL+"/authorize", "application/json", strings.NewReader(`{"spec": `))
+	assert.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestSubjectAccessReviewGroups(t *testing.T) {
+	ts := newTestSubjectAccessReview(t, &SubjectAccessReviewConfig{
+		Enforcer: "kubernetes",
+		Path:     "/sar",
+		Params:   []string{"group", "namespace", "resource", "verb"},
+		Deny:     true,
+	})
+
+	status := postReview(t, ts.URL+"/sar", sarCIDeployments)
+	assert.True(t, status.Allowed)
+
+	// alice is a developer by user name, not by any of the groups.
+	status = postReview(t, ts.URL+"/sar", sarAlicePods)
+	assert.False(t, status.Allowed)
+	assert.True(t, status.Denied)
+}
+
+func TestSubjectAccessReviewErrors(t *testing.T) {
+	ts := newTestSubjectAccessReview(t, &SubjectAccessReviewConfig{Enforcer: "missing"})
+
+	status := postReview(t, ts.URL+"/authorize", sarAlicePods)
+	assert.False(t, status.Allowed)
+	assert.NotEmpty(t, status.EvaluationError)
+
+	// The body is limited like the one of the gateway.
+	resp, err := http.Post(ts.URL+"/authorize", "application/json", strings.NewReader(`{"spec": {"user": "`+strings.Repeat("a", maxGatewayBodySize)+`"}}`))
+	assert.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusRequestEntityTooLarge, resp.StatusCode)
+
+	s := NewServer()
+	_, err = NewSubjectAccessReview(s, &SubjectAccessReviewConfig{})
+	assert.Error(t, err)
+	_, err = NewSubjectAccessReview(s, &SubjectAccessReviewConfig{Enforcer: "kubernetes", Params: []string{"user", "cluster"}})
+	assert.Error(t, err)
+	_, err = NewSubjectAccessReview(s, &SubjectAccessReviewConfig{Enforcer: "kubernetes", Params: []string{"group", "group"}})
+	assert.Error(t, err)
+
+	config, err := LoadSubjectAccessReviewConfig("../examples/sar_config.json")
+	assert.NoError(t, err)
+	_, err = NewSubjectAccessReview(s, config)
+	assert.NoError(t, err)
+}
@@ -23,9 +23,10 @@ import (
 )
 
 type testEngine struct {
-	s   *Server
-	ctx context.Context
-	h   int32
+	s         *Server
+	ctx       context.Context
+	h         int32
+	modelText string
 }
 
 func newTestEngine(t *testing.T, from, connectStr string, modelLoc string) *testEngine {
@@ -47,5 +48,5 @@ func newTestEngine(t *testing.T, from, connectStr string, modelLoc string) *test
 		t.Fatal(err)
 	}
 
-	return &testEngine{s: s, ctx: ctx, h: resp.Handler}
+	return &testEngine{s: s, ctx: ctx, h: resp.Handler, modelText: string(modelText)}
 }
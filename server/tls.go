@@ -0,0 +1,174 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// certCheckInterval is how often the certificate files are checked for changes.
+const certCheckInterval = 10 * time.Second
+
+// certReloader serves the TLS config built from the certificate files and
+// rebuilds it when one of the files is modified, so certificates can be rotated
+// without restarting the server. The files are checked at most once per interval,
+// by a single handshake while the others keep using the current config.
+type certReloader struct {
+	certFile     string
+	keyFile      string
+	clientCAFile string
+	interval     time.Duration
+
+	config atomic.Pointer[tls.Config]
+
+	// mu guards the fields below. It is held while checking the files.
+	mu      sync.Mutex
+	checked time.Time
+	modTime map[string]time.Time
+	// failedModTime are the modification times of the files that last failed to load,
+	// so that a failure is logged once until the files change again.
+	failedModTime map[string]time.Time
+}
+
+// NewTLSConfig creates the TLS config of the server from a PEM certificate and key.
+// If clientCAFile is not empty, clients must present a certificate signed by one of
+// its CAs (mutual TLS). The files are reloaded when they change.
+func NewTLSConfig(certFile, keyFile, clientCAFile string) (*tls.Config, error) {
+	return newTLSConfig(certFile, keyFile, clientCAFile, certCheckInterval)
+}
+
+func newTLSConfig(certFile, keyFile, clientCAFile string, interval time.Duration) (*tls.Config, error) {
+	if certFile == "" || keyFile == "" {
+		return nil, errors.New("both a TLS certificate and key are required")
+	}
+
+	r := &certReloader{certFile: certFile, keyFile: keyFile, clientCAFile: clientCAFile, interval: interval}
+	if err := r.load(r.stat()); err != nil {
+		return nil, err
+	}
+	r.checked = time.Now()
+
+	return &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		GetCertificate:     r.getCertificate,
+		GetConfigForClient: r.getConfigForClient,
+	}, nil
+}
+
+func (r *certReloader) files() []string {
+	files := []string{r.certFile, r.keyFile}
+	if r.clientCAFile != "" {
+		files = append(files, r.clientCAFile)
+	}
+	return files
+}
+
+// stat returns the modification times of the files, zero for those that are missing.
+func (r *certReloader) stat() map[string]time.Time {
+	modTime := map[string]time.Time{}
+	for _, file := range r.files() {
+		if info, err := os.Stat(file); err == nil {
+			modTime[file] = info.ModTime()
+		} else {
+			modTime[file] = time.Time{}
+		}
+	}
+	return modTime
+}
+
+func sameModTime(a, b map[string]time.Time) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for file, t := range a {
+		if !t.Equal(b[file]) {
+			return false
+		}
+	}
+	return true
+}
+
+// load builds the config from the files, which were modified at modTime.
+func (r *certReloader) load(modTime map[string]time.Time) error {
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return err
+	}
+
+	config := &tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		Certificates: []tls.Certificate{cert},
+		NextProtos:   []string{"h2", "http/1.1"},
+	}
+
+	if r.clientCAFile != "" {
+		pem, err := os.ReadFile(r.clientCAFile)
+		if err != nil {
+			return err
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return fmt.Errorf("no certificate found in %s", r.clientCAFile)
+		}
+		config.ClientCAs = pool
+		config.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	r.modTime = modTime
+	r.config.Store(config)
+	return nil
+}
+
+// reload rebuilds the config if the files changed since they were last loaded.
+func (r *certReloader) reload() {
+	modTime := r.stat()
+	if sameModTime(modTime, r.modTime) || sameModTime(modTime, r.failedModTime) {
+		return
+	}
+	// Keep serving the previous certificate while the files are half written.
+	if err := r.load(modTime); err != nil {
+		logf("failed to reload TLS certificate: %v", err)
+		r.failedModTime = modTime
+		return
+	}
+	r.failedModTime = nil
+}
+
+func (r *certReloader) current() *tls.Config {
+	// The handshakes never wait for another one checking the files.
+	if r.mu.TryLock() {
+		if now := time.Now(); now.Sub(r.checked) >= r.interval {
+			r.checked = now
+			r.reload()
+		}
+		r.mu.Unlock()
+	}
+	return r.config.Load()
+}
+
+func (r *certReloader) getConfigForClient(*tls.ClientHelloInfo) (*tls.Config, error) {
+	return r.current(), nil
+}
+
+func (r *certReloader) getCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	return &r.current().Certificates[0], nil
+}
@@ -0,0 +1,266 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"log"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	pb "github.com/casbin/casbin-server/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+func newTestCA(t *testing.T) *testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, _ := x509.ParseCertificate(der)
+	return &testCA{cert: cert, key: key, pem: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})}
+}
+
+// issue returns a PEM certificate and key signed by the CA.
+func (ca *testCA) issue(t *testing.T, cn string, usage x509.ExtKeyUsage) ([]byte, []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	serial, _ := rand.Int(rand.Reader, big.NewInt(1<<62))
+	tmpl := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: cn},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, &key.PublicKey, ca.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+func writeTestFile(t *testing.T, path string, data []byte, modTime time.Time) {
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(path, modTime, modTime); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func serveTLS(t *testing.T, config *tls.Config) string {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := grpc.NewServer(grpc.Creds(credentials.NewTLS(config)))
+	pb.RegisterCasbinServer(s, NewServer())
+	go func() { _ = s.Serve(lis) }()
+	t.Cleanup(s.Stop)
+	return lis.Addr().String()
+}
+
+func callTLS(addr string, config *tls.Config) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, err := grpc.DialContext(ctx, addr, grpc.WithTransportCredentials(credentials.NewTLS(config)))
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	_, err = pb.NewCasbinClient(conn).ListEnforcers(ctx, &pb.EmptyRequest{})
+	return err
+}
+
+func TestTLS(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCA(t)
+	certFile, keyFile := filepath.Join(dir, "server.crt"), filepath.Join(dir, "server.key")
+	cert, key := ca.issue(t, "server-1", x509.ExtKeyUsageServerAuth)
+	writeTestFile(t, certFile, cert, time.Now())
+	writeTestFile(t, keyFile, key, time.Now())
+
+	config, err := NewTLSConfig(certFile, keyFile, "")
+	assert.NoError(t, err)
+	addr := serveTLS(t, config)
+
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.cert)
+	assert.NoError(t, callTLS(addr, &tls.Config{RootCAs: roots}))
+
+	// A client that does not trust the CA is rejected.
+	assert.Error(t, callTLS(addr, &tls.Config{RootCAs: x509.NewCertPool()}))
+
+	_, err = NewTLSConfig(certFile, "", "")
+	assert.Error(t, err)
+	_, err = NewTLSConfig(certFile, filepath.Join(dir, "missing.key"), "")
+	assert.Error(t, err)
+}
+
+func TestMutualTLS(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCA(t)
+	certFile, keyFile, caFile := filepath.Join(dir, "server.crt"), filepath.Join(dir, "server.key"), filepath.Join(dir, "ca.crt")
+	cert, key := ca.issue(t, "server", x509.ExtKeyUsageServerAuth)
+	writeTestFile(t, certFile, cert, time.Now())
+	writeTestFile(t, keyFile, key, time.Now())
+	writeTestFile(t, caFile, ca.pem, time.Now())
+
+	config, err := NewTLSConfig(certFile, keyFile, caFile)
+	assert.NoError(t, err)
+	addr := serveTLS(t, config)
+
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.cert)
+	assert.Error(t, callTLS(addr, &tls.Config{RootCAs: roots}))
+
+	clientCert, clientKey := ca.issue(t, "ci-bot", x509.ExtKeyUsageClientAuth)
+	pair, err := tls.X509KeyPair(clientCert, clientKey)
+	assert.NoError(t, err)
+	assert.NoError(t, callTLS(addr, &tls.Config{RootCAs: roots, Certificates: []tls.Certificate{pair}}))
+
+	// A client certificate from another CA is rejected.
+	otherCert, otherKey := newTestCA(t).issue(t, "intruder", x509.ExtKeyUsageClientAuth)
+	pair, err = tls.X509KeyPair(otherCert, otherKey)
+	assert.NoError(t, err)
+	assert.Error(t, callTLS(addr, &tls.Config{RootCAs: roots, Certificates: []tls.Certificate{pair}}))
+}
+
+func TestTLSReload(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCA(t)
+	certFile, keyFile := filepath.Join(dir, "server.crt"), filepath.Join(dir, "server.key")
+	cert, key := ca.issue(t, "server-1", x509.ExtKeyUsageServerAuth)
+	modTime := time.Now().Add(-time.Minute)
+	writeTestFile(t, certFile, cert, modTime)
+	writeTestFile(t, keyFile, key, modTime)
+
+	config, err := newTLSConfig(certFile, keyFile, "", 0)
+	assert.NoError(t, err)
+
+	lis, err := tls.Listen("tcp", "127.0.0.1:0", config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer lis.Close()
+	go func() {
+		for {
+			conn, err := lis.Accept()
+			if err != nil {
+				return
+			}
+			_ = conn.(*tls.Conn).Handshake()
+			conn.Close()
+		}
+	}()
+
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.cert)
+	serverName := func() string {
+		conn, err := tls.Dial("tcp", lis.Addr().String(), &tls.Config{RootCAs: roots})
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer conn.Close()
+		return conn.ConnectionState().PeerCertificates[0].Subject.CommonName
+	}
+	assert.Equal(t, "server-1", serverName())
+
+	cert, key = ca.issue(t, "server-2", x509.ExtKeyUsageServerAuth)
+	writeTestFile(t, certFile, cert, time.Now())
+	writeTestFile(t, keyFile, key, time.Now())
+	assert.Equal(t, "server-2", serverName())
+
+	// A broken certificate is not loaded, the previous one is kept, and the failure is
+	// only logged once.
+	var logs bytes.Buffer
+	log.SetOutput(&logs)
+	defer log.SetOutput(os.Stderr)
+	writeTestFile(t, certFile, []byte("garbage"), time.Now().Add(time.Minute))
+	assert.Equal(t, "server-2", serverName())
+	assert.Equal(t, "server-2", serverName())
+	assert.Equal(t, 1, strings.Count(logs.String(), "failed to reload TLS certificate"))
+}
+
+func TestTLSReloadInterval(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCA(t)
+	certFile, keyFile := filepath.Join(dir, "server.crt"), filepath.Join(dir, "server.key")
+	cert, key := ca.issue(t, "server-1", x509.ExtKeyUsageServerAuth)
+	writeTestFile(t, certFile, cert, time.Now().Add(-time.Minute))
+	writeTestFile(t, keyFile, key, time.Now().Add(-time.Minute))
+
+	config, err := newTLSConfig(certFile, keyFile, "", time.Hour)
+	assert.NoError(t, err)
+	current := func() string {
+		c, err := config.GetCertificate(nil)
+		assert.NoError(t, err)
+		leaf, err := x509.ParseCertificate(c.Certificate[0])
+		assert.NoError(t, err)
+		return leaf.Subject.CommonName
+	}
+
+	// The files are not checked again before the interval has passed.
+	cert, key = ca.issue(t, "server-2", x509.ExtKeyUsageServerAuth)
+	writeTestFile(t, certFile, cert, time.Now())
+	writeTestFile(t, keyFile, key, time.Now())
+	assert.Equal(t, "server-1", current())
+}
@@ -0,0 +1,167 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracer creates the spans of the server. It uses the global TracerProvider, so
+// no spans are recorded unless one is set, e.g. with NewTracerProvider.
+var tracer = otel.Tracer("github.com/casbin/casbin-server/server")
+
+// TracingConfig configures how the OpenTelemetry spans of the server are exported.
+type TracingConfig struct {
+	// Exporter is "otlp" (OTLP over gRPC), "stdout" or "file".
+	Exporter string `json:"exporter"`
+	// Endpoint is the host:port of the OTLP collector. By default, it is read from
+	// OTEL_EXPORTER_OTLP_ENDPOINT, or is localhost:4317.
+	Endpoint string `json:"endpoint"`
+	// Insecure disables TLS for the connection to the OTLP collector.
+	Insecure bool `json:"insecure"`
+	// Headers are sent to the OTLP collector, e.g. to authenticate.
+	Headers map[string]string `json:"headers"`
+	// File is the path the spans are appended to as JSON by the file exporter.
+	File string `json:"file"`
+	// ServiceName is the service.name of the spans, "casbin-server" by default.
+	ServiceName string `json:"serviceName"`
+	// SampleRatio is the fraction of the traces started by the server that are
+	// recorded, 1 by default. Traces started by the caller follow its decision.
+	SampleRatio *float64 `json:"sampleRatio"`
+}
+
+// LoadTracingConfig reads a TracingConfig from a JSON file.
+func LoadTracingConfig(path string) (*TracingConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	config := &TracingConfig{}
+	if err := json.Unmarshal(data, config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// NewTracerProvider creates a TracerProvider exporting spans as configured. It is
+// meant to be set with otel.SetTracerProvider and shut down when the server stops.
+func NewTracerProvider(ctx context.Context, config *TracingConfig) (*sdktrace.TracerProvider, error) {
+	ratio := 1.0
+	if config.SampleRatio != nil {
+		ratio = *config.SampleRatio
+	}
+	if ratio < 0 || ratio > 1 {
+		return nil, fmt.Errorf("invalid sampleRatio %v, it must be between 0 and 1", ratio)
+	}
+
+	serviceName := config.ServiceName
+	if serviceName == "" {
+		serviceName = "casbin-server"
+	}
+	res, err := resource.Merge(resource.Default(), resource.NewSchemaless(attribute.String("service.name", serviceName)))
+	if err != nil {
+		return nil, err
+	}
+
+	var exporter sdktrace.SpanExporter
+	// The local exporters write every span as it ends, so that none is lost when the server is killed.
+	batch := false
+	switch config.Exporter {
+	case "otlp":
+		opts := []otlptracegrpc.Option{}
+		if config.Endpoint != "" {
+			opts = append(opts, otlptracegrpc.WithEndpoint(config.Endpoint))
+		}
+		if config.Insecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+		if len(config.Headers) > 0 {
+			opts = append(opts, otlptracegrpc.WithHeaders(config.Headers))
+		}
+		exporter, err = otlptracegrpc.New(ctx, opts...)
+		if err != nil {
+			return nil, err
+		}
+		batch = true
+	case "stdout":
+		exporter, err = stdouttrace.New(stdouttrace.WithPrettyPrint())
+		if err != nil {
+			return nil, err
+		}
+	case "file":
+		if config.File == "" {
+			return nil, errors.New("the file exporter requires file")
+		}
+		f, err := os.OpenFile(config.File, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+		if err != nil {
+			return nil, err
+		}
+		stdout, err := stdouttrace.New(stdouttrace.WithWriter(f))
+		if err != nil {
+			f.Close()
+			return nil, err
+		}
+		exporter = &fileExporter{SpanExporter: stdout, file: f}
+	default:
+		return nil, fmt.Errorf("unknown tracing exporter %q, currently supported: otlp | stdout | file", config.Exporter)
+	}
+
+	opts := []sdktrace.TracerProviderOption{
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(ratio))),
+	}
+	if batch {
+		opts = append(opts, sdktrace.WithBatcher(exporter))
+	} else {
+		opts = append(opts, sdktrace.WithSyncer(exporter))
+	}
+	return sdktrace.NewTracerProvider(opts...), nil
+}
+
+// fileExporter closes the file the spans are written to when it is shut down.
+type fileExporter struct {
+	sdktrace.SpanExporter
+	file *os.File
+}
+
+func (e *fileExporter) Shutdown(ctx context.Context) error {
+	err := e.SpanExporter.Shutdown(ctx)
+	if closeErr := e.file.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// endSpan records err, if any, on span and ends it.
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelcodes.Error, err.Error())
+	}
+	span.End()
+}
@@ -0,0 +1,160 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	pb "github.com/casbin/casbin-server/proto"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+var (
+	testSpanExporter = tracetest.NewInMemoryExporter()
+	setTestTracer    sync.Once
+)
+
+// recordSpans makes the spans of the server available from the returned exporter.
+// The global TracerProvider can only be set once, so all the tests share it.
+func recordSpans(t *testing.T) *tracetest.InMemoryExporter {
+	setTestTracer.Do(func() {
+		otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(testSpanExporter)))
+		otel.SetTextMapPropagator(propagation.TraceContext{})
+	})
+	testSpanExporter.Reset()
+	return testSpanExporter
+}
+
+func spanNames(spans tracetest.SpanStubs) []string {
+	names := make([]string, len(spans))
+	for i, span := range spans {
+		names[i] = span.Name
+	}
+	return names
+}
+
+func TestTracing(t *testing.T) {
+	exporter := recordSpans(t)
+	e := newTestEngine(t, "file", "../examples/rbac_policy.csv", "../examples/rbac_model.conf")
+	assert.Equal(t, []string{"adapter.connect", "adapter.load"}, spanNames(exporter.GetSpans()))
+
+	ctx, parent := otel.Tracer("test").Start(context.Background(), "client")
+	data1, _ := MakeABAC(struct{ Name string }{Name: "data1"})
+	exporter.Reset()
+	_, err := e.s.Enforce(ctx, &pb.EnforceRequest{EnforcerHandler: e.h, Params: []string{"alice", data1, "read"}})
+	assert.NoError(t, err)
+	_, err = e.s.LoadPolicy(ctx, &pb.EmptyRequest{Handler: e.h})
+	assert.NoError(t, err)
+	parent.End()
+
+	spans := exporter.GetSpans()
+	assert.Equal(t, []string{"ResolveABAC", "casbin.Enforce", "adapter.load", "client"}, spanNames(spans))
+	for _, span := range spans[:3] {
+		assert.Equal(t, parent.SpanContext().TraceID(), span.SpanContext.TraceID())
+		assert.Equal(t, parent.SpanContext().SpanID(), span.Parent.SpanID())
+	}
+
+	// Adapter errors are recorded on the span.
+	exporter.Reset()
+	_, err = e.s.NewAdapter(ctx, &pb.NewAdapterRequest{DriverName: "mysql", ConnectString: "invalid"})
+	assert.Error(t, err)
+	spans = exporter.GetSpans()
+	if assert.Len(t, spans, 1) {
+		assert.Equal(t, "adapter.connect", spans[0].Name)
+		assert.NotEmpty(t, spans[0].Events)
+	}
+}
+
+func TestTracingGateway(t *testing.T) {
+	exporter := recordSpans(t)
+	e := newTestEngine(t, "file", "../examples/rbac_policy.csv", "../examples/rbac_model.conf")
+	ts := httptest.NewServer(NewGateway(e.s, RecoveryUnaryInterceptor))
+	defer ts.Close()
+	exporter.Reset()
+
+	req, err := http.NewRequest(http.MethodPost, ts.URL+"/v1/enforcers/0/enforce", strings.NewReader(`{"params": ["alice", "data1", "read"]}`))
+	assert.NoError(t, err)
+	req.Header.Set("traceparent", "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01")
+	resp, err := http.DefaultClient.Do(req)
+	assert.NoError(t, err)
+	resp.Body.Close()
+
+	spans := exporter.GetSpans()
+	if assert.Len(t, spans, 2) {
+		assert.Equal(t, "casbin.Enforce", spans[0].Name)
+		assert.Equal(t, "proto.Casbin/Enforce", spans[1].Name)
+		assert.Equal(t, "0af7651916cd43dd8448eb211c80319c", spans[1].SpanContext.TraceID().String())
+		assert.Equal(t, "b7ad6b7169203331", spans[1].Parent.SpanID().String())
+	}
+}
+
+func TestNewTracerProvider(t *testing.T) {
+	ratio := 2.0
+	_, err := NewTracerProvider(context.Background(), &TracingConfig{Exporter: "stdout", SampleRatio: &ratio})
+	assert.Error(t, err)
+	_, err = NewTracerProvider(context.Background(), &TracingConfig{Exporter: "zipkin"})
+	assert.Error(t, err)
+	_, err = NewTracerProvider(context.Background(), &TracingConfig{Exporter: "file"})
+	assert.Error(t, err)
+
+	path := filepath.Join(t.TempDir(), "traces.json")
+	tp, err := NewTracerProvider(context.Background(), &TracingConfig{Exporter: "file", File: path, ServiceName: "authz"})
+	assert.NoError(t, err)
+	_, span := tp.Tracer("test").Start(context.Background(), "test-span")
+	span.End()
+	assert.NoError(t, tp.Shutdown(context.Background()))
+
+	data, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	var exported struct {
+		Name     string
+		Resource []struct {
+			Key   string
+			Value struct{ Value interface{} }
+		}
+	}
+	assert.NoError(t, json.Unmarshal(data, &exported))
+	assert.Equal(t, "test-span", exported.Name)
+	assert.Contains(t, exported.Resource, struct {
+		Key   string
+		Value struct{ Value interface{} }
+	}{Key: "service.name", Value: struct{ Value interface{} }{Value: "authz"}})
+
+	// Shutting down the exporter closes its file.
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0644)
+	assert.NoError(t, err)
+	stdout, err := stdouttrace.New(stdouttrace.WithWriter(f))
+	assert.NoError(t, err)
+	assert.NoError(t, (&fileExporter{SpanExporter: stdout, file: f}).Shutdown(context.Background()))
+	_, err = f.Write([]byte("{}"))
+	assert.ErrorIs(t, err, os.ErrClosed)
+
+	tp, err = NewTracerProvider(context.Background(), &TracingConfig{Exporter: "otlp", Endpoint: "localhost:4317", Insecure: true})
+	assert.NoError(t, err)
+	assert.NoError(t, tp.Shutdown(context.Background()))
+}
@@ -0,0 +1,164 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"strings"
+
+	pb "github.com/casbin/casbin-server/proto"
+	"google.golang.org/grpc/codes"
+)
+
+// ReasonWatchOverflow is the reason of the error ending a WatchPolicy stream that could not keep up with the changes.
+const ReasonWatchOverflow = "WATCH_OVERFLOW"
+
+// watchBufferSize is the number of events buffered for a WatchPolicy stream. A
+// stream that falls further behind is ended, as its client has missed changes.
+const watchBufferSize = 256
+
+var errWatchOverflow = newError(codes.ResourceExhausted, ReasonWatchOverflow, "too many policy events pending, reload the policy and watch again")
+
+type policyWatcher struct {
+	events chan *pb.PolicyEvent
+	// overflow is set before events is closed when the watcher fell behind.
+	overflow bool
+}
+
+// watchPolicy registers a watcher of the policy changes of the enforcer behind handle.
+// The events channel is closed when the enforcer is freed.
+func (s *Server) watchPolicy(handle int) (*policyWatcher, func(), error) {
+	s.muW.Lock()
+	defer s.muW.Unlock()
+
+	// Checked under muW, so that a concurrent FreeEnforcer closes the new watcher.
+	if _, err := s.getEnforcer(handle); err != nil {
+		return nil, nil, err
+	}
+
+	w := &policyWatcher{events: make(chan *pb.PolicyEvent, watchBufferSize)}
+	if s.watchers[handle] == nil {
+		s.watchers[handle] = map[*policyWatcher]struct{}{}
+	}
+	s.watchers[handle][w] = struct{}{}
+
+	cancel := func() {
+		s.muW.Lock()
+		defer s.muW.Unlock()
+		if _, ok := s.watchers[handle][w]; ok {
+			delete(s.watchers[handle], w)
+			close(w.events)
+		}
+	}
+	return w, cancel, nil
+}
+
+// closeWatchers ends the watchers of the enforcer behind handle.
+func (s *Server) closeWatchers(handle int) {
+	s.muW.Lock()
+	defer s.muW.Unlock()
+
+	for w := range s.watchers[handle] {
+		close(w.events)
+	}
+	delete(s.watchers, handle)
+}
+
+// publishPolicyEvent tells the watchers of the enforcer behind handle that its policy
+// changed, and drops the decisions it cached.
+func (s *Server) publishPolicyEvent(handle int, event *pb.PolicyEvent) {
+	if c := s.getCache(handle); c != nil {
+		c.invalidate()
+	}
+
+	s.muW.Lock()
+	defer s.muW.Unlock()
+
+	for w := range s.watchers[handle] {
+		select {
+		case w.events <- event:
+		default:
+			w.overflow = true
+			delete(s.watchers[handle], w)
+			close(w.events)
+		}
+	}
+}
+
+func wrapRules(rules [][]string) []*pb.PoliciesRequestRule {
+	wrapped := make([]*pb.PoliciesRequestRule, len(rules))
+	for i, rule := range rules {
+		wrapped[i] = &pb.PoliciesRequestRule{Params: rule}
+	}
+	return wrapped
+}
+
+func policySection(ptype string) string {
+	if strings.HasPrefix(ptype, "g") {
+		return "g"
+	}
+	return "p"
+}
+
+// notifyPolicy tells the watchers of the enforcer that rules of ptype were added or removed.
+func (s *Server) notifyPolicy(handle int32, eventType pb.PolicyEventType, ptype string, rules [][]string) {
+	if len(rules) == 0 {
+		return
+	}
+	s.publishPolicyEvent(int(handle), &pb.PolicyEvent{
+		EventType: eventType,
+		Sec:       policySection(ptype),
+		PType:     ptype,
+		Rules:     wrapRules(rules),
+	})
+}
+
+// notifyPolicyUpdate tells the watchers of the enforcer that oldRules of ptype were replaced by newRules.
+func (s *Server) notifyPolicyUpdate(handle int32, ptype string, oldRules [][]string, newRules [][]string) {
+	s.publishPolicyEvent(int(handle), &pb.PolicyEvent{
+		EventType: pb.PolicyEvent_UPDATE,
+		Sec:       policySection(ptype),
+		PType:     ptype,
+		Rules:     wrapRules(newRules),
+		OldRules:  wrapRules(oldRules),
+	})
+}
+
+// WatchPolicy streams the changes made to the policy of an enforcer: added, removed
+// and updated rules, and LoadPolicy and SavePolicy calls. The stream ends when the
+// enforcer is freed.
+func (s *Server) WatchPolicy(in *pb.EmptyRequest, stream pb.Casbin_WatchPolicyServer) error {
+	w, cancel, err := s.watchPolicy(int(in.Handler))
+	if err != nil {
+		return err
+	}
+	defer cancel()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case event, ok := <-w.events:
+			if !ok {
+				if w.overflow {
+					return errWatchOverflow
+				}
+				return nil
+			}
+			if err := stream.Send(event); err != nil {
+				return err
+			}
+		}
+	}
+}
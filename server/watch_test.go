@@ -0,0 +1,178 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/casbin/casbin-server/proto"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type testWatchStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	events chan *pb.PolicyEvent
+}
+
+func (s *testWatchStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *testWatchStream) Send(event *pb.PolicyEvent) error {
+	s.events <- event
+	return nil
+}
+
+// startWatch runs WatchPolicy on the enforcer and returns the stream and the result of the call.
+func startWatch(t *testing.T, e *testEngine) (*testWatchStream, context.CancelFunc, chan error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &testWatchStream{ctx: ctx, events: make(chan *pb.PolicyEvent)}
+	done := make(chan error, 1)
+	go func() {
+		done <- e.s.WatchPolicy(&pb.EmptyRequest{Handler: e.h}, stream)
+	}()
+
+	assert.Eventually(t, func() bool {
+		e.s.muW.Lock()
+		defer e.s.muW.Unlock()
+		return len(e.s.watchers[int(e.h)]) > 0
+	}, time.Second, time.Millisecond)
+	return stream, cancel, done
+}
+
+func nextEvent(t *testing.T, stream *testWatchStream) *pb.PolicyEvent {
+	t.Helper()
+	select {
+	case event := <-stream.events:
+		return event
+	case <-time.After(time.Second):
+		t.Fatal("no policy event")
+		return nil
+	}
+}
+
+func eventRules(rules []*pb.PoliciesRequestRule) [][]string {
+	res := make([][]string, len(rules))
+	for i, rule := range rules {
+		res[i] = rule.Params
+	}
+	return res
+}
+
+func TestWatchPolicy(t *testing.T) {
+	e := newTestEngine(t, "file", "../examples/rbac_policy.csv", "../examples/rbac_model.conf")
+	stream, cancel, done := startWatch(t, e)
+
+	_, err := e.s.AddPolicy(e.ctx, &pb.PolicyRequest{EnforcerHandler: e.h, Params: []string{"eve", "data3", "read"}})
+	assert.NoError(t, err)
+	event := nextEvent(t, stream)
+	assert.Equal(t, pb.PolicyEvent_ADD, event.EventType)
+	assert.Equal(t, "p", event.Sec)
+	assert.Equal(t, "p", event.PType)
+	assert.Equal(t, [][]string{{"eve", "data3", "read"}}, eventRules(event.Rules))
+
+	// A rule that already exists does not change anything, though casbin reports it as added.
+	reply, err := e.s.AddPolicy(e.ctx, &pb.PolicyRequest{EnforcerHandler: e.h, Params: []string{"eve", "data3", "read"}})
+	assert.NoError(t, err)
+	assert.True(t, reply.Res)
+
+	_, err = e.s.RemoveFilteredPolicy(e.ctx, &pb.FilteredPolicyRequest{EnforcerHandler: e.h, FieldIndex: 1, FieldValues: []string{"data2"}})
+	assert.NoError(t, err)
+	event = nextEvent(t, stream)
+	assert.Equal(t, pb.PolicyEvent_REMOVE, event.EventType)
+	assert.Equal(t, [][]string{{"bob", "data2", "write"}, {"data2_admin", "data2", "read"}, {"data2_admin", "data2", "write"}}, eventRules(event.Rules))
+
+	_, err = e.s.UpdatePolicy(e.ctx, &pb.UpdatePolicyRequest{EnforcerHandler: e.h, OldRule: []string{"eve", "data3", "read"}, NewRule: []string{"eve", "data3", "write"}})
+	assert.NoError(t, err)
+	event = nextEvent(t, stream)
+	assert.Equal(t, pb.PolicyEvent_UPDATE, event.EventType)
+	assert.Equal(t, [][]string{{"eve", "data3", "read"}}, eventRules(event.OldRules))
+	assert.Equal(t, [][]string{{"eve", "data3", "write"}}, eventRules(event.Rules))
+
+	_, err = e.s.AddRoleForUser(e.ctx, &pb.UserRoleRequest{EnforcerHandler: e.h, User: "eve", Role: "data3_admin"})
+	assert.NoError(t, err)
+	event = nextEvent(t, stream)
+	assert.Equal(t, pb.PolicyEvent_ADD, event.EventType)
+	assert.Equal(t, "g", event.Sec)
+	assert.Equal(t, [][]string{{"eve", "data3_admin"}}, eventRules(event.Rules))
+
+	_, err = e.s.DeleteRole(e.ctx, &pb.UserRoleRequest{EnforcerHandler: e.h, Role: "data3_admin"})
+	assert.NoError(t, err)
+	event = nextEvent(t, stream)
+	assert.Equal(t, pb.PolicyEvent_REMOVE, event.EventType)
+	assert.Equal(t, "g", event.Sec)
+	assert.Equal(t, [][]string{{"data3_admin", "data4_admin"}, {"george", "data3_admin"}, {"eve", "data3_admin"}}, eventRules(event.Rules))
+	event = nextEvent(t, stream)
+	assert.Equal(t, "p", event.Sec)
+	assert.Equal(t, [][]string{{"data3_admin", "data3", "admin"}}, eventRules(event.Rules))
+
+	_, err = e.s.LoadPolicy(e.ctx, &pb.EmptyRequest{Handler: e.h})
+	assert.NoError(t, err)
+	assert.Equal(t, pb.PolicyEvent_LOAD, nextEvent(t, stream).EventType)
+
+	cancel()
+	assert.NoError(t, <-done)
+}
+
+func TestWatchPolicyEnd(t *testing.T) {
+	e := newTestEngine(t, "file", "../examples/rbac_policy.csv", "../examples/rbac_model.conf")
+
+	stream, cancel, done := startWatch(t, e)
+	defer cancel()
+	_, err := e.s.FreeEnforcer(e.ctx, &pb.EmptyRequest{Handler: e.h})
+	assert.NoError(t, err)
+	assert.NoError(t, <-done)
+	assert.Empty(t, stream.events)
+
+	err = e.s.WatchPolicy(&pb.EmptyRequest{Handler: e.h}, stream)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestWatchPolicyOverflow(t *testing.T) {
+	e := newTestEngine(t, "file", "../examples/rbac_policy.csv", "../examples/rbac_model.conf")
+
+	w, cancel, err := e.s.watchPolicy(int(e.h))
+	assert.NoError(t, err)
+	defer cancel()
+
+	for i := 0; i <= watchBufferSize; i++ {
+		e.s.publishPolicyEvent(int(e.h), &pb.PolicyEvent{EventType: pb.PolicyEvent_LOAD})
+	}
+	for range w.events {
+	}
+	assert.True(t, w.overflow)
+
+	// The WatchPolicy stream of an overflowed watcher sends the pending events and ends with ResourceExhausted.
+	stream, cancelStream, done := startWatch(t, e)
+	defer cancelStream()
+	for i := 0; i <= watchBufferSize+1; i++ {
+		e.s.publishPolicyEvent(int(e.h), &pb.PolicyEvent{EventType: pb.PolicyEvent_LOAD})
+	}
+	for {
+		select {
+		case <-stream.events:
+			continue
+		case err = <-done:
+		}
+		break
+	}
+	assertErrorReason(t, err, codes.ResourceExhausted, ReasonWatchOverflow)
+}
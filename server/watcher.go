@@ -0,0 +1,276 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"runtime/debug"
+	"strings"
+
+	pb "github.com/casbin/casbin-server/proto"
+	"github.com/casbin/casbin/v2"
+	"github.com/casbin/casbin/v2/model"
+	"github.com/casbin/casbin/v2/persist"
+	rediswatcher "github.com/casbin/redis-watcher/v2"
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/grpc/codes"
+)
+
+// supportedWatcherDriverNames are the values of WatcherDriverName in NewEnforcerRequest.
+var supportedWatcherDriverNames = []string{"redis"}
+
+var (
+	errWatcherDriverName = newError(codes.InvalidArgument, ReasonInvalidDriver, "currently supported WatcherDriverName: "+strings.Join(supportedWatcherDriverNames, " | "))
+	errWatcherName       = newError(codes.InvalidArgument, ReasonInvalidArgument, "a watcher can only be attached to a named enforcer")
+)
+
+// watcherChannel is the Redis channel shared by the enforcers named name on all the servers.
+func watcherChannel(name string) string {
+	return "/casbin/" + name
+}
+
+// newWatcher creates the watcher of the enforcer e named name. The changes received
+// from the other servers are applied by s to e.
+func (s *Server) newWatcher(name string, e *casbin.SyncedEnforcer, driverName string, connectString string) (persist.Watcher, error) {
+	switch driverName {
+	case "redis":
+		if !strings.Contains(connectString, "://") {
+			connectString = "redis://" + connectString
+		}
+		options, err := redis.ParseURL(connectString)
+		if err != nil {
+			return nil, invalidArgumentError(err)
+		}
+		w, err := rediswatcher.NewWatcher(options.Addr, rediswatcher.WatcherOptions{
+			Options:    *options,
+			Channel:    watcherChannel(name),
+			IgnoreSelf: true,
+			OptionalUpdateCallback: func(msg string) {
+				s.applyWatcherUpdate(name, e, msg)
+			},
+		})
+		if err != nil {
+			return nil, watcherError(err)
+		}
+		return w, nil
+	default:
+		return nil, errWatcherDriverName
+	}
+}
+
+// newEnforcerWatcher creates the watcher requested for the enforcer e, or the one of
+// the config file if the request has none. It returns nil if no watcher is configured.
+func (s *Server) newEnforcerWatcher(in *pb.NewEnforcerRequest, e *casbin.SyncedEnforcer) (persist.Watcher, error) {
+	driverName, connectString := in.WatcherDriverName, in.WatcherConnectString
+	if driverName == "" {
+		if in.Name == "" {
+			return nil, nil
+		}
+		// Only named enforcers can be kept in sync, so the config file applies to them alone.
+		if _, err := os.Stat(getLocalConfigPath()); err != nil {
+			return nil, nil
+		}
+		cfg, err := loadLocalConfig()
+		if err != nil {
+			return nil, err
+		}
+		driverName, connectString = cfg.Watcher, cfg.WatcherConnection
+		if driverName == "" {
+			return nil, nil
+		}
+	}
+	if in.Name == "" {
+		return nil, errWatcherName
+	}
+
+	w, err := s.newWatcher(in.Name, e, driverName, connectString)
+	if err != nil {
+		return nil, err
+	}
+	if err := e.SetWatcher(w); err != nil {
+		w.Close()
+		return nil, watcherError(err)
+	}
+	return w, nil
+}
+
+func watcherError(err error) error {
+	return wrapError(codes.Unavailable, ReasonWatcherUnavailable, err)
+}
+
+// applyWatcherUpdate applies a change made on another server to e, the enforcer named
+// name. Rules are added and removed one by one rather than reloading the whole policy,
+// and only in memory, as the other server has already saved them with the adapter.
+func (s *Server) applyWatcherUpdate(name string, e *casbin.SyncedEnforcer, msg string) {
+	update := &rediswatcher.MSG{}
+	if err := update.UnmarshalBinary([]byte(msg)); err != nil {
+		logf("invalid watcher update of enforcer %s: %v", name, err)
+		return
+	}
+
+	event, err := s.applyPolicyUpdateOrReload(name, e, update)
+	if err != nil {
+		logf("failed to apply watcher update of enforcer %s: %v", name, err)
+		return
+	}
+	if event == nil {
+		return
+	}
+
+	// The enforcer may have been freed, or replaced by another one of the same name.
+	h, err := s.getEnforcerHandleByName(name)
+	if err != nil {
+		return
+	}
+	if current, err := s.getEnforcer(h); err != nil || current != e {
+		return
+	}
+	s.publishPolicyEvent(h, event)
+}
+
+// applyPolicyUpdateOrReload applies update like applyPolicyUpdate, but reloads the whole
+// policy if that panics: the update is applied on the goroutine of the watcher, which the
+// recovery interceptors do not cover, so a bad message would kill every subscribed server.
+func (s *Server) applyPolicyUpdateOrReload(name string, e *casbin.SyncedEnforcer, update *rediswatcher.MSG) (event *pb.PolicyEvent, err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			logf("panic applying watcher update of enforcer %s, reloading its policy: %v\n%s", name, p, debug.Stack())
+			event = &pb.PolicyEvent{EventType: pb.PolicyEvent_LOAD}
+			err = s.adapterCall(context.Background(), "load", e.LoadPolicy)
+		}
+	}()
+	return s.applyPolicyUpdate(e, update)
+}
+
+// applyPolicyUpdate applies update to the model of e and returns the event to publish
+// to the WatchPolicy streams, or nil if the policy did not change. A SavePolicy made
+// elsewhere replaces the whole policy, so it is reloaded from the adapter.
+func (s *Server) applyPolicyUpdate(e *casbin.SyncedEnforcer, update *rediswatcher.MSG) (*pb.PolicyEvent, error) {
+	if update.Method == rediswatcher.Update || update.Method == rediswatcher.UpdateForSavePolicy {
+		if err := s.adapterCall(context.Background(), "load", e.LoadPolicy); err != nil {
+			return nil, err
+		}
+		return &pb.PolicyEvent{EventType: pb.PolicyEvent_LOAD}, nil
+	}
+
+	e.GetLock().Lock()
+	defer e.GetLock().Unlock()
+
+	m := e.GetModel()
+	event := &pb.PolicyEvent{Sec: update.Sec, PType: update.Ptype}
+
+	switch update.Method {
+	case rediswatcher.UpdateForAddPolicy, rediswatcher.UpdateForAddPolicies:
+		rules := update.NewRules
+		if update.Method == rediswatcher.UpdateForAddPolicy {
+			rules = [][]string{update.NewRule}
+		}
+		added, err := m.AddPoliciesWithAffected(update.Sec, update.Ptype, rules)
+		if err != nil || len(added) == 0 {
+			return nil, err
+		}
+		if err := buildRoleLinks(e, model.PolicyAdd, update.Sec, update.Ptype, added); err != nil {
+			return nil, err
+		}
+		event.EventType = pb.PolicyEvent_ADD
+		event.Rules = wrapRules(added)
+	case rediswatcher.UpdateForRemovePolicy, rediswatcher.UpdateForRemovePolicies:
+		rules := update.NewRules
+		if update.Method == rediswatcher.UpdateForRemovePolicy {
+			rules = [][]string{update.NewRule}
+		}
+		removed, err := m.RemovePoliciesWithAffected(update.Sec, update.Ptype, rules)
+		if err != nil || len(removed) == 0 {
+			return nil, err
+		}
+		if err := buildRoleLinks(e, model.PolicyRemove, update.Sec, update.Ptype, removed); err != nil {
+			return nil, err
+		}
+		event.EventType = pb.PolicyEvent_REMOVE
+		event.Rules = wrapRules(removed)
+	case rediswatcher.UpdateForRemoveFilteredPolicy:
+		_, removed, err := m.RemoveFilteredPolicy(update.Sec, update.Ptype, update.FieldIndex, update.FieldValues...)
+		if err != nil || len(removed) == 0 {
+			return nil, err
+		}
+		if err := buildRoleLinks(e, model.PolicyRemove, update.Sec, update.Ptype, removed); err != nil {
+			return nil, err
+		}
+		event.EventType = pb.PolicyEvent_REMOVE
+		event.Rules = wrapRules(removed)
+	case rediswatcher.UpdateForUpdatePolicy, rediswatcher.UpdateForUpdatePolicies:
+		oldRules, newRules := update.OldRules, update.NewRules
+		if update.Method == rediswatcher.UpdateForUpdatePolicy {
+			oldRules, newRules = [][]string{update.OldRule}, [][]string{update.NewRule}
+		}
+		if canUpdateInPlace(m, update.Sec, update.Ptype, oldRules, newRules) {
+			updated, err := m.UpdatePolicies(update.Sec, update.Ptype, oldRules, newRules)
+			if err != nil || !updated {
+				return nil, err
+			}
+		} else {
+			// UpdateFilteredPolicies replaces any number of rules with any number of
+			// others, which the other server removed then added like this.
+			removed, err := m.RemovePoliciesWithAffected(update.Sec, update.Ptype, oldRules)
+			if err != nil {
+				return nil, err
+			}
+			added, err := m.AddPoliciesWithAffected(update.Sec, update.Ptype, newRules)
+			if err != nil {
+				return nil, err
+			}
+			if len(removed) == 0 && len(added) == 0 {
+				return nil, nil
+			}
+			oldRules, newRules = removed, added
+		}
+		if err := buildRoleLinks(e, model.PolicyRemove, update.Sec, update.Ptype, oldRules); err != nil {
+			return nil, err
+		}
+		if err := buildRoleLinks(e, model.PolicyAdd, update.Sec, update.Ptype, newRules); err != nil {
+			return nil, err
+		}
+		event.EventType = pb.PolicyEvent_UPDATE
+		event.Rules = wrapRules(newRules)
+		event.OldRules = wrapRules(oldRules)
+	default:
+		return nil, fmt.Errorf("unknown update type %q", update.Method)
+	}
+	return event, nil
+}
+
+// canUpdateInPlace reports whether the rules can be replaced at the same position, like
+// UpdatePolicies does: one new rule for each old one, none of them already in the policy.
+func canUpdateInPlace(m model.Model, sec string, ptype string, oldRules, newRules [][]string) bool {
+	if len(oldRules) == 0 || len(oldRules) != len(newRules) {
+		return false
+	}
+	for _, rule := range newRules {
+		if has, err := m.HasPolicy(sec, ptype, rule); err != nil || has {
+			return false
+		}
+	}
+	return true
+}
+
+// buildRoleLinks updates the role links of e after grouping rules were added or removed.
+func buildRoleLinks(e *casbin.SyncedEnforcer, op model.PolicyOp, sec string, ptype string, rules [][]string) error {
+	if sec != "g" {
+		return nil
+	}
+	return e.BuildIncrementalRoleLinks(op, ptype, rules)
+}
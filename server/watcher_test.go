@@ -0,0 +1,204 @@
+// Copyright 2018 The casbin Authors. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package server
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	miniredis "github.com/alicebob/miniredis/v2"
+	pb "github.com/casbin/casbin-server/proto"
+	rediswatcher "github.com/casbin/redis-watcher/v2"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+// newReplica creates a server with the RBAC example enforcer named "shared", synced through the Redis at addr.
+func newReplica(t *testing.T, addr string) *testEngine {
+	e := newTestEngine(t, "file", "../examples/rbac_policy.csv", "../examples/rbac_model.conf")
+	resp, err := e.s.NewEnforcer(e.ctx, &pb.NewEnforcerRequest{
+		ModelText:            e.modelText,
+		AdapterHandle:        0,
+		Name:                 "shared",
+		WatcherDriverName:    "redis",
+		WatcherConnectString: addr,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	e.h = resp.Handler
+	t.Cleanup(func() {
+		_, _ = e.s.FreeEnforcer(e.ctx, &pb.EmptyRequest{Handler: e.h})
+	})
+	return e
+}
+
+func assertEventuallyAllowed(t *testing.T, e *testEngine, allowed bool, params ...string) {
+	t.Helper()
+	assert.Eventually(t, func() bool {
+		resp, err := e.s.Enforce(e.ctx, &pb.EnforceRequest{EnforcerHandler: e.h, Params: params})
+		return err == nil && resp.Res == allowed
+	}, 2*time.Second, 10*time.Millisecond)
+}
+
+// assertSamePolicy asserts that the replicas a and b have the same policy.
+func assertSamePolicy(t *testing.T, a, b *testEngine) {
+	t.Helper()
+	expected, err := a.s.GetPolicy(a.ctx, &pb.EmptyRequest{Handler: a.h})
+	assert.NoError(t, err)
+	policy, err := b.s.GetPolicy(b.ctx, &pb.EmptyRequest{Handler: b.h})
+	assert.NoError(t, err)
+	assert.Equal(t, expected.D2, policy.D2)
+}
+
+func TestWatcher(t *testing.T) {
+	r := miniredis.RunT(t)
+	a := newReplica(t, r.Addr())
+	b := newReplica(t, r.Addr())
+
+	stream, cancel, done := startWatch(t, b)
+
+	_, err := a.s.AddPolicy(a.ctx, &pb.PolicyRequest{EnforcerHandler: a.h, Params: []string{"eve", "data3", "read"}})
+	assert.NoError(t, err)
+	assertEventuallyAllowed(t, b, true, "eve", "data3", "read")
+	event := nextEvent(t, stream)
+	assert.Equal(t, pb.PolicyEvent_ADD, event.EventType)
+	assert.Equal(t, [][]string{{"eve", "data3", "read"}}, eventRules(event.Rules))
+
+	_, err = a.s.UpdatePolicy(a.ctx, &pb.UpdatePolicyRequest{EnforcerHandler: a.h, OldRule: []string{"eve", "data3", "read"}, NewRule: []string{"eve", "data3", "write"}})
+	assert.NoError(t, err)
+	assertEventuallyAllowed(t, b, true, "eve", "data3", "write")
+	assertEventuallyAllowed(t, b, false, "eve", "data3", "read")
+	assert.Equal(t, pb.PolicyEvent_UPDATE, nextEvent(t, stream).EventType)
+
+	_, err = a.s.UpdatePolicies(a.ctx, &pb.UpdatePoliciesRequest{EnforcerHandler: a.h,
+		OldRules: []*pb.PoliciesRequestRule{{Params: []string{"eve", "data3", "write"}}, {Params: []string{"bob", "data2", "write"}}},
+		NewRules: []*pb.PoliciesRequestRule{{Params: []string{"eve", "data3", "admin"}}, {Params: []string{"bob", "data2", "read"}}}})
+	assert.NoError(t, err)
+	assertEventuallyAllowed(t, b, true, "bob", "data2", "read")
+	assert.Equal(t, pb.PolicyEvent_UPDATE, nextEvent(t, stream).EventType)
+	assertSamePolicy(t, a, b)
+
+	_, err = a.s.AddRoleForUser(a.ctx, &pb.UserRoleRequest{EnforcerHandler: a.h, User: "eve", Role: "data2_admin"})
+	assert.NoError(t, err)
+	assertEventuallyAllowed(t, b, true, "eve", "data2", "read")
+	assert.Equal(t, "g", nextEvent(t, stream).Sec)
+
+	// The filtered rules may be replaced by fewer rules.
+	_, err = a.s.UpdateFilteredPolicies(a.ctx, &pb.UpdateFilteredPoliciesRequest{EnforcerHandler: a.h,
+		NewRules:   []*pb.PoliciesRequestRule{{Params: []string{"data2_admin", "data2", "admin"}}},
+		FieldIndex: 0, FieldValues: []string{"data2_admin"}})
+	assert.NoError(t, err)
+	assertEventuallyAllowed(t, b, true, "eve", "data2", "admin")
+	assertEventuallyAllowed(t, b, false, "eve", "data2", "read")
+	event = nextEvent(t, stream)
+	assert.Equal(t, pb.PolicyEvent_UPDATE, event.EventType)
+	assert.Equal(t, [][]string{{"data2_admin", "data2", "read"}, {"data2_admin", "data2", "write"}}, eventRules(event.OldRules))
+	assert.Equal(t, [][]string{{"data2_admin", "data2", "admin"}}, eventRules(event.Rules))
+	assertSamePolicy(t, a, b)
+
+	_, err = a.s.RemoveFilteredPolicy(a.ctx, &pb.FilteredPolicyRequest{EnforcerHandler: a.h, FieldIndex: 1, FieldValues: []string{"data2"}})
+	assert.NoError(t, err)
+	assertEventuallyAllowed(t, b, false, "eve", "data2", "admin")
+	event = nextEvent(t, stream)
+	assert.Equal(t, pb.PolicyEvent_REMOVE, event.EventType)
+	assert.Equal(t, [][]string{{"bob", "data2", "read"}, {"data2_admin", "data2", "admin"}}, eventRules(event.Rules))
+
+	// Changes flow both ways, and each server only applies the changes of the others.
+	_, err = b.s.RemovePolicy(b.ctx, &pb.PolicyRequest{EnforcerHandler: b.h, Params: []string{"alice", "data1", "read"}})
+	assert.NoError(t, err)
+	assertEventuallyAllowed(t, a, false, "alice", "data1", "read")
+	assert.Equal(t, pb.PolicyEvent_REMOVE, nextEvent(t, stream).EventType)
+
+	assertSamePolicy(t, a, b)
+
+	cancel()
+	assert.NoError(t, <-done)
+}
+
+func TestWatcherPanic(t *testing.T) {
+	r := miniredis.RunT(t)
+	b := newReplica(t, r.Addr())
+	e, err := b.s.getEnforcer(int(b.h))
+	assert.NoError(t, err)
+	_, err = e.AddPolicy("eve", "data3", "read")
+	assert.NoError(t, err)
+	stream, cancel, done := startWatch(t, b)
+
+	// A filter past the end of the rules panics in casbin. The policy is reloaded instead.
+	msg, err := (&rediswatcher.MSG{Method: rediswatcher.UpdateForRemoveFilteredPolicy, Sec: "p", Ptype: "p", FieldIndex: 5, FieldValues: []string{"data1"}}).MarshalBinary()
+	assert.NoError(t, err)
+	assert.NotPanics(t, func() { b.s.applyWatcherUpdate("shared", e, string(msg)) })
+	assert.Equal(t, pb.PolicyEvent_LOAD, nextEvent(t, stream).EventType)
+	has, err := e.HasPolicy("eve", "data3", "read")
+	assert.NoError(t, err)
+	assert.False(t, has)
+
+	cancel()
+	assert.NoError(t, <-done)
+}
+
+func TestWatcherConfig(t *testing.T) {
+	r := miniredis.RunT(t)
+
+	// The watcher of the config file applies to the named enforcers created without one.
+	config := filepath.Join(t.TempDir(), "connection_config.json")
+	err := os.WriteFile(config, []byte(`{"driver": "file", "connection": "../examples/rbac_policy.csv", "enforcer": "../examples/rbac_model.conf", "watcher": "redis", "watcherConnection": "$WATCHER_ADDR"}`), 0600)
+	assert.NoError(t, err)
+	t.Setenv(configFilePathEnvironmentVariable, config)
+	t.Setenv("WATCHER_ADDR", r.Addr())
+
+	a := newTestEngine(t, "file", "../examples/rbac_policy.csv", "../examples/rbac_model.conf")
+	b := newReplica(t, r.Addr())
+	resp, err := a.s.NewEnforcer(a.ctx, &pb.NewEnforcerRequest{ModelText: a.modelText, AdapterHandle: 0, Name: "shared"})
+	assert.NoError(t, err)
+	a.h = resp.Handler
+	assert.Contains(t, a.s.watcherMap, int(a.h))
+
+	_, err = a.s.AddPolicy(a.ctx, &pb.PolicyRequest{EnforcerHandler: a.h, Params: []string{"eve", "data3", "read"}})
+	assert.NoError(t, err)
+	assertEventuallyAllowed(t, b, true, "eve", "data3", "read")
+
+	// Unnamed enforcers have no watcher.
+	resp, err = a.s.NewEnforcer(a.ctx, &pb.NewEnforcerRequest{ModelText: a.modelText, AdapterHandle: 0})
+	assert.NoError(t, err)
+	assert.NotContains(t, a.s.watcherMap, int(resp.Handler))
+
+	_, err = a.s.FreeEnforcer(a.ctx, &pb.EmptyRequest{Handler: a.h})
+	assert.NoError(t, err)
+	assert.Empty(t, a.s.watcherMap)
+}
+
+func TestWatcherErrors(t *testing.T) {
+	e := newTestEngine(t, "file", "../examples/rbac_policy.csv", "../examples/rbac_model.conf")
+
+	_, err := e.s.NewEnforcer(e.ctx, &pb.NewEnforcerRequest{ModelText: e.modelText, AdapterHandle: 0, WatcherDriverName: "redis", WatcherConnectString: "localhost:6379"})
+	assertErrorReason(t, err, codes.InvalidArgument, ReasonInvalidArgument)
+
+	_, err = e.s.NewEnforcer(e.ctx, &pb.NewEnforcerRequest{ModelText: e.modelText, AdapterHandle: 0, Name: "shared", WatcherDriverName: "etcd"})
+	assertErrorReason(t, err, codes.InvalidArgument, ReasonInvalidDriver)
+
+	r := miniredis.RunT(t)
+	addr := r.Addr()
+	r.Close()
+	_, err = e.s.NewEnforcer(e.ctx, &pb.NewEnforcerRequest{ModelText: e.modelText, AdapterHandle: 0, Name: "shared", WatcherDriverName: "redis", WatcherConnectString: addr})
+	assertErrorReason(t, err, codes.Unavailable, ReasonWatcherUnavailable)
+
+	// A failed watcher does not leave the name taken.
+	_, err = e.s.GetEnforcerByName(e.ctx, &pb.EnforcerNameRequest{Name: "shared"})
+	assertErrorReason(t, err, codes.NotFound, ReasonEnforcerNotFound)
+}